@@ -0,0 +1,362 @@
+package query
+
+import (
+	"fmt"
+	"math"
+)
+
+// An Aggregator computes an aggregate function, like count or sum, over
+// the rows of a group.
+type Aggregator interface {
+	// Add adds a row's values of the aggregate's columns. Rows that
+	// are missing one of the columns, or have a nil value for it,
+	// aren't added, unless the aggregate's NullPolicy is NullsAsZero.
+	// count(*) and count_if(...) add rows with no values.
+	Add(values ...interface{}) error
+	// Result returns the aggregate of the rows added so far.
+	Result() interface{}
+}
+
+// A NullPolicy is how an aggregate treats rows where its column is
+// missing or nil.
+type NullPolicy int
+
+const (
+	// NullsSkipped leaves the rows out, like SQL, so count(x) only
+	// counts rows with a value of x and sum(x) is null if there are
+	// none. It's the default for every aggregate.
+	NullsSkipped NullPolicy = iota
+
+	// NullsAsZero aggregates the rows as if their value was 0.
+	NullsAsZero
+)
+
+// WithNullPolicy sets the NullPolicy of an aggregate, like "sum".
+// count(*) and count_if(...) have no column, so they're unaffected.
+func WithNullPolicy(aggregate string, policy NullPolicy) Option {
+	return func(e *Executor) {
+		if e.nullPolicies == nil {
+			e.nullPolicies = map[string]NullPolicy{}
+		}
+		e.nullPolicies[aggregate] = policy
+	}
+}
+
+type countAggregator struct {
+	n int
+}
+
+func newCountAggregator() Aggregator {
+	return &countAggregator{}
+}
+
+func (a *countAggregator) Add(values ...interface{}) error {
+	a.n++
+	return nil
+}
+
+func (a *countAggregator) Result() interface{} {
+	return a.n
+}
+
+// sumAggregator sums numbers. The sum is an int while every number is
+// an integer and a float64 after that.
+type sumAggregator struct {
+	n        int
+	intSum   int64
+	floatSum float64
+	floats   bool
+}
+
+func newSumAggregator() Aggregator {
+	return &sumAggregator{}
+}
+
+func (a *sumAggregator) Add(values ...interface{}) error {
+	if i, ok := toInt64(values[0]); ok && !a.floats {
+		a.intSum += i
+		a.n++
+		return nil
+	}
+	f, err := aggregateNumber(values[0])
+	if err != nil {
+		return err
+	}
+	if !a.floats {
+		a.floats = true
+		a.floatSum = float64(a.intSum)
+	}
+	a.floatSum += f
+	a.n++
+	return nil
+}
+
+// Result returns nil if no numbers were added.
+func (a *sumAggregator) Result() interface{} {
+	switch {
+	case a.n == 0:
+		return nil
+	case a.floats:
+		return a.floatSum
+	}
+	return int(a.intSum)
+}
+
+// avgAggregator averages numbers as float64s.
+type avgAggregator struct {
+	n   int
+	sum float64
+}
+
+func newAvgAggregator() Aggregator {
+	return &avgAggregator{}
+}
+
+func (a *avgAggregator) Add(values ...interface{}) error {
+	f, err := aggregateNumber(values[0])
+	if err != nil {
+		return err
+	}
+	a.sum += f
+	a.n++
+	return nil
+}
+
+// Result returns nil if no numbers were added.
+func (a *avgAggregator) Result() interface{} {
+	if a.n == 0 {
+		return nil
+	}
+	return a.sum / float64(a.n)
+}
+
+// extremeAggregator keeps the least or greatest value, comparing
+// numbers by value and strings lexically. Values that can't be compared
+// with each other, like a string and a number, are an error.
+type extremeAggregator struct {
+	value interface{}
+	// sign is -1 to keep the least value and 1 for the greatest.
+	sign int
+}
+
+func newMinAggregator() Aggregator {
+	return &extremeAggregator{sign: -1}
+}
+
+func newMaxAggregator() Aggregator {
+	return &extremeAggregator{sign: 1}
+}
+
+func (a *extremeAggregator) Add(values ...interface{}) error {
+	v := values[0]
+	if a.value == nil {
+		a.value = v
+		return nil
+	}
+	c, ok := compareInterfaces(v, a.value)
+	if !ok {
+		return fmt.Errorf("can't compare %s and %s", formatValue(v), formatValue(a.value))
+	}
+	if c*a.sign > 0 {
+		a.value = v
+	}
+	return nil
+}
+
+// Result returns nil if no values were added.
+func (a *extremeAggregator) Result() interface{} {
+	return a.value
+}
+
+// anyAggregator keeps the first value added, so any(b) carries along a
+// value of b for each group. Its result is nil if no values were added.
+type anyAggregator struct {
+	value interface{}
+}
+
+func newAnyAggregator() Aggregator {
+	return &anyAggregator{}
+}
+
+func (a *anyAggregator) Add(values ...interface{}) error {
+	if a.value == nil {
+		a.value = values[0]
+	}
+	return nil
+}
+
+func (a *anyAggregator) Result() interface{} {
+	return a.value
+}
+
+// modeAggregator returns the most frequent value, or of the most
+// frequent values the one first added. Values are told apart like
+// DISTINCT ON keys. It keeps a count for every distinct value, so its
+// memory grows with the number of distinct values in the group.
+type modeAggregator struct {
+	counts map[string]*modeCount
+	mode   *modeCount
+}
+
+type modeCount struct {
+	value interface{}
+	n     int
+	// first is the number of distinct values added before it.
+	first int
+}
+
+func newModeAggregator() Aggregator {
+	return &modeAggregator{counts: map[string]*modeCount{}}
+}
+
+func (a *modeAggregator) Add(values ...interface{}) error {
+	key := fmt.Sprintf("%#v", values[0])
+	c, ok := a.counts[key]
+	if !ok {
+		c = &modeCount{value: values[0], first: len(a.counts)}
+		a.counts[key] = c
+	}
+	c.n++
+	if a.mode == nil || c.n > a.mode.n || c.n == a.mode.n && c.first < a.mode.first {
+		a.mode = c
+	}
+	return nil
+}
+
+// Result returns nil if no values were added.
+func (a *modeAggregator) Result() interface{} {
+	if a.mode == nil {
+		return nil
+	}
+	return a.mode.value
+}
+
+// corrAggregator computes the correlation of two columns. Its result
+// is nil if the correlation is undefined.
+type corrAggregator struct {
+	correlation
+}
+
+func newCorrAggregator() Aggregator {
+	return &corrAggregator{}
+}
+
+func (a *corrAggregator) Add(values ...interface{}) error {
+	x, err := aggregateNumber(values[0])
+	if err != nil {
+		return err
+	}
+	y, err := aggregateNumber(values[1])
+	if err != nil {
+		return err
+	}
+	a.add(x, y)
+	return nil
+}
+
+func (a *corrAggregator) Result() interface{} {
+	if r, ok := a.result(); ok {
+		return r
+	}
+	return nil
+}
+
+// varianceAggregator computes the sample variance of numbers, or their
+// standard deviation if stddev is set. Its result is nil for fewer
+// than two numbers, where the sample variance is undefined.
+type varianceAggregator struct {
+	variance
+	stddev bool
+}
+
+func newVarianceAggregator() Aggregator {
+	return &varianceAggregator{}
+}
+
+func newStddevAggregator() Aggregator {
+	return &varianceAggregator{stddev: true}
+}
+
+func (a *varianceAggregator) Add(values ...interface{}) error {
+	x, err := aggregateNumber(values[0])
+	if err != nil {
+		return err
+	}
+	a.add(x)
+	return nil
+}
+
+func (a *varianceAggregator) Result() interface{} {
+	v, ok := a.result()
+	if !ok {
+		return nil
+	}
+	if a.stddev {
+		return math.Sqrt(v)
+	}
+	return v
+}
+
+// aggregateNumber returns v as a float64, or an error if it isn't a
+// number.
+func aggregateNumber(v interface{}) (float64, error) {
+	f, ok := toFloat64(v)
+	if !ok {
+		return 0, fmt.Errorf("%s isn't a number", formatValue(v))
+	}
+	return f, nil
+}
+
+// correlation computes the Pearson correlation of pairs of values in
+// one pass, updating the means and co-moments as each pair arrives
+// (Welford's method) so large values don't lose precision.
+type correlation struct {
+	n            float64
+	meanX, meanY float64
+	m2X, m2Y     float64
+	coMoment     float64
+}
+
+func (c *correlation) add(x, y float64) {
+	c.n++
+	dx := x - c.meanX
+	c.meanX += dx / c.n
+	dy := y - c.meanY
+	c.meanY += dy / c.n
+	c.m2X += dx * (x - c.meanX)
+	c.m2Y += dy * (y - c.meanY)
+	c.coMoment += dx * (y - c.meanY)
+}
+
+// result returns the correlation, or false if it's undefined because
+// there are fewer than two pairs or either column is constant.
+func (c *correlation) result() (float64, bool) {
+	if c.n < 2 || c.m2X == 0 || c.m2Y == 0 {
+		return 0, false
+	}
+	return c.coMoment / math.Sqrt(c.m2X*c.m2Y), true
+}
+
+// variance computes the sample variance of values in one pass with
+// Welford's method, like correlation.
+type variance struct {
+	n    float64
+	mean float64
+	m2   float64
+}
+
+func (v *variance) add(x float64) {
+	v.n++
+	d := x - v.mean
+	v.mean += d / v.n
+	v.m2 += d * (x - v.mean)
+}
+
+// result returns the variance, or false if there are fewer than two
+// values.
+func (v *variance) result() (float64, bool) {
+	if v.n < 2 {
+		return 0, false
+	}
+	return v.m2 / (v.n - 1), true
+}
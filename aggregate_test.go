@@ -0,0 +1,310 @@
+package query
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseCorr(t *testing.T) {
+	q, err := Parse("SELECT region, corr(latency, size) GROUP BY region")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ColumnDesc{Name: "latency", Aggregate: "corr", Arguments: []string{"size"}}
+	if !reflect.DeepEqual(q.Columns[1], expected) {
+		t.Errorf("expected %+v, got %+v", expected, q.Columns[1])
+	}
+	if err := q.Validate(); err != nil {
+		t.Error(err)
+	}
+	if pretty := q.Pretty(); pretty != "SELECT region, corr(latency, size)\nGROUP BY region" {
+		t.Errorf("unexpected pretty query %q", pretty)
+	}
+
+	for _, query := range []string{"SELECT corr(a)", "SELECT corr(a, b, c)", "SELECT sum(a, b)"} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if err := q.Validate(); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	cases := []struct {
+		x, y     []float64
+		expected float64
+		ok       bool
+	}{
+		{[]float64{1, 2, 3, 4}, []float64{2, 4, 6, 8}, 1, true},
+		{[]float64{1, 2, 3, 4}, []float64{8, 6, 4, 2}, -1, true},
+		{[]float64{1, 2, 3}, []float64{1, 3, 2}, 0.5, true},
+		{[]float64{1e9 + 1, 1e9 + 2, 1e9 + 3}, []float64{1, 3, 2}, 0.5, true},
+		{[]float64{1}, []float64{1}, 0, false},
+		{[]float64{1, 2, 3}, []float64{5, 5, 5}, 0, false},
+	}
+	for _, c := range cases {
+		var corr correlation
+		for i := range c.x {
+			corr.add(c.x[i], c.y[i])
+		}
+		r, ok := corr.result()
+		if ok != c.ok || math.Abs(r-c.expected) > 1e-9 {
+			t.Errorf("corr(%v, %v): expected %v, %v, got %v, %v", c.x, c.y, c.expected, c.ok, r, ok)
+		}
+	}
+}
+
+func TestCountAggregate(t *testing.T) {
+	cases := []struct {
+		table    Table
+		query    string
+		expected []map[string]interface{}
+	}{
+		{testDataTable{}, "SELECT count(id)", []map[string]interface{}{{"count(id)": 4}}},
+		{testDataTable{}, "SELECT count(id) WHERE id > 10", []map[string]interface{}{{"count(id)": 0}}},
+		// Rows without region, or with a nil region, aren't counted
+		// by count(region).
+		{testGroups, "SELECT count(*), count(region), count_if(kind = \"a\")", []map[string]interface{}{
+			{"count(*)": 8, "count(region)": 5, `count_if(kind = "a")`: 4},
+		}},
+		{testGroups, "SELECT kind, count(*) GROUP BY kind", []map[string]interface{}{
+			{"kind": "a", "count(*)": 4},
+			{"kind": "b", "count(*)": 3},
+			{"kind": "c", "count(*)": 1},
+		}},
+		{testGroups, "SELECT region, count(id) WHERE id > 1 GROUP BY region LIMIT 2", []map[string]interface{}{
+			{"region": nil, "count(id)": 3},
+			{"region": "eu", "count(id)": 2},
+		}},
+		{testGroups, `SELECT kind, count_if(region = "us") GROUP BY kind ORDER BY count_if(region = "us") DESC`, []map[string]interface{}{
+			{"kind": "a", `count_if(region = "us")`: 2},
+			{"kind": "b", `count_if(region = "us")`: 1},
+			{"kind": "c", `count_if(region = "us")`: 0},
+		}},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, c.table, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	for _, query := range []string{"SELECT foo(id)", "SELECT sum(*)", "SELECT count_if(region)"} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(testGroups).Execute(q); err == nil || errors.Is(err, ErrUnsupported) {
+			t.Errorf("%s: expected an error, got %v", query, err)
+		}
+	}
+}
+
+func TestSumAndAvg(t *testing.T) {
+	numbers := testSliceTable{
+		{"id": 1, "kind": "int", "n": 1},
+		{"id": 2, "kind": "int", "n": int64(2)},
+		{"id": 3, "kind": "mixed", "n": 1},
+		{"id": 4, "kind": "mixed", "n": 2.5},
+		{"id": 5, "kind": "mixed", "n": int8(3)},
+		{"id": 6, "kind": "none"},
+		{"id": 7, "kind": "none", "n": nil},
+	}
+	cases := []struct {
+		table    Table
+		query    string
+		expected []map[string]interface{}
+	}{
+		{testDataTable{}, "SELECT sum(a), avg(b)", []map[string]interface{}{{"sum(a)": 4, "avg(b)": 2.0}}},
+		{numbers, "SELECT kind, sum(n), avg(n) GROUP BY kind", []map[string]interface{}{
+			{"kind": "int", "sum(n)": 3, "avg(n)": 1.5},
+			{"kind": "mixed", "sum(n)": 6.5, "avg(n)": 6.5 / 3},
+			{"kind": "none", "sum(n)": nil, "avg(n)": nil},
+		}},
+		{numbers, "SELECT corr(id, n) WHERE kind = \"int\"", []map[string]interface{}{{"corr(id, n)": 1.0}}},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, c.table, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	q, err := Parse("SELECT sum(name)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewExecutor(testNames).Execute(q)
+	if expected := `query: sum(name): "John" isn't a number`; err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
+
+func TestMinAndMax(t *testing.T) {
+	cases := []struct {
+		table    Table
+		query    string
+		expected []map[string]interface{}
+	}{
+		{testNames, "SELECT min(id), max(id), min(name), max(name)", []map[string]interface{}{
+			{"min(id)": 1, "max(id)": 4, "min(name)": "Alison", "max(name)": "jolene"},
+		}},
+		{testSliceTable{
+			{"kind": "a", "n": 2.5},
+			{"kind": "a", "n": 1},
+			{"kind": "b", "n": int64(7)},
+			{"kind": "b", "n": 10},
+			{"kind": "c"},
+		}, "SELECT kind, min(n), max(n) GROUP BY kind", []map[string]interface{}{
+			{"kind": "a", "min(n)": 1, "max(n)": 2.5},
+			{"kind": "b", "min(n)": int64(7), "max(n)": 10},
+			{"kind": "c", "min(n)": nil, "max(n)": nil},
+		}},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, c.table, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	q, err := Parse("SELECT max(v)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewExecutor(testSliceTable{{"v": 1}, {"v": "x"}}).Execute(q)
+	if expected := `query: max(v): can't compare "x" and 1`; err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
+
+func TestMultipleAggregates(t *testing.T) {
+	table := testSliceTable{
+		{"a": "x", "b": 1, "c": 3, "d": 1.5},
+		{"a": "x", "b": 1, "c": -2, "d": 2},
+		{"a": "y", "b": 2, "c": 7},
+		{"a": "x", "b": 1, "c": 10, "d": 0.5},
+	}
+	rows := executeRows(t, table, "SELECT a, b, min(c), max(c), sum(d), count(d), avg(c) AS mean GROUP BY a, b")
+	expected := []map[string]interface{}{
+		{"a": "x", "b": 1, "min(c)": -2, "max(c)": 10, "sum(d)": 4.0, "count(d)": 3, "mean": 11.0 / 3},
+		{"a": "y", "b": 2, "min(c)": 7, "max(c)": 7, "sum(d)": nil, "count(d)": 0, "mean": 7.0},
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("expected %d rows, got %v", len(expected), rows)
+	}
+	for i := range expected {
+		for field, value := range expected[i] {
+			if got, ok := rows[i][field]; !ok || !reflect.DeepEqual(got, value) {
+				t.Errorf("row %d: expected %s = %#v, got %#v", i, field, value, got)
+			}
+		}
+		if len(rows[i]) != len(expected[i]) {
+			t.Errorf("row %d: expected fields %v, got %v", i, expected[i], rows[i])
+		}
+	}
+}
+
+func TestVarianceAggregates(t *testing.T) {
+	table := testSliceTable{}
+	for _, x := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		table = append(table, map[string]interface{}{"g": "a", "x": x, "big": 1e9 + x})
+	}
+	table = append(table, map[string]interface{}{"g": "b", "x": 1}, map[string]interface{}{"g": "c"})
+
+	rows := executeRows(t, table, "SELECT g, variance(x), stddev(x), variance(big) GROUP BY g")
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 groups, got %v", rows)
+	}
+	// The sample variance of the first group is 32/7.
+	for field, expected := range map[string]float64{"variance(x)": 32.0 / 7, "stddev(x)": math.Sqrt(32.0 / 7), "variance(big)": 32.0 / 7} {
+		got, ok := rows[0][field].(float64)
+		if !ok || math.Abs(got-expected) > 1e-6 {
+			t.Errorf("%s: expected %v, got %v", field, expected, rows[0][field])
+		}
+	}
+	// Groups with fewer than two values have no variance.
+	for _, row := range rows[1:] {
+		if row["variance(x)"] != nil || row["stddev(x)"] != nil {
+			t.Errorf("expected nil variance, got %v", row)
+		}
+	}
+}
+
+func TestAnyAggregate(t *testing.T) {
+	rows := executeRows(t, testGroups, "SELECT kind, any(region), any(id) GROUP BY kind")
+	expected := []map[string]interface{}{
+		{"kind": "a", "any(region)": "us", "any(id)": 1},
+		{"kind": "b", "any(region)": "eu", "any(id)": 2},
+		{"kind": "c", "any(region)": nil, "any(id)": 8},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v, got %v", expected, rows)
+	}
+}
+
+func TestModeAggregate(t *testing.T) {
+	table := testSliceTable{
+		{"g": "a", "status": "ok"},
+		{"g": "a", "status": "error"},
+		{"g": "a", "status": "error"},
+		{"g": "a"},
+		{"g": "a", "status": "ok"},
+		{"g": "b", "status": 1},
+		{"g": "b", "status": 2},
+		{"g": "b", "status": 2},
+		{"g": "c"},
+	}
+	rows := executeRows(t, table, "SELECT g, mode(status) GROUP BY g")
+	// Ties go to the value seen first.
+	expected := []map[string]interface{}{
+		{"g": "a", "mode(status)": "ok"},
+		{"g": "b", "mode(status)": 2},
+		{"g": "c", "mode(status)": nil},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v, got %v", expected, rows)
+	}
+}
+
+func TestNullPolicy(t *testing.T) {
+	table := testSliceTable{
+		{"x": 2},
+		{"x": nil},
+		{},
+		{"x": 4},
+	}
+	query := "SELECT count(*), count(x), sum(x), avg(x), min(x), max(x)"
+	skipped := map[string]interface{}{"count(*)": 4, "count(x)": 2, "sum(x)": 6, "avg(x)": 3.0, "min(x)": 2, "max(x)": 4}
+	if rows := executeRows(t, table, query); !reflect.DeepEqual(rows, []map[string]interface{}{skipped}) {
+		t.Errorf("expected %v, got %v", skipped, rows)
+	}
+
+	var options []Option
+	for _, name := range []string{"count", "sum", "avg", "min", "max"} {
+		options = append(options, WithNullPolicy(name, NullsAsZero))
+	}
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewExecutor(table, options...).Execute(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero := map[string]interface{}{"count(*)": 4, "count(x)": 4, "sum(x)": 6, "avg(x)": 1.5, "min(x)": 0, "max(x)": 4}
+	if rows := res.Rows(); len(rows) != 1 || !reflect.DeepEqual(rows[0].(resultRow).values, zero) {
+		t.Errorf("expected %v, got %v", zero, rows)
+	}
+
+	// The policy only applies to the aggregates it's set for.
+	res, err = NewExecutor(table, WithNullPolicy("avg", NullsAsZero)).Execute(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row := res.Rows()[0].(resultRow).values; row["avg(x)"] != 1.5 || row["count(x)"] != 2 || row["min(x)"] != 2 {
+		t.Errorf("expected only avg to count nulls as zero, got %v", row)
+	}
+}
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"fmt"
+	"sync"
+)
+
+// An evalError records the first error filters run into evaluating
+// rows, like arithmetic on a string. Filters can't return errors, so
+// the executor checks it after filtering each row.
+type evalError struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (e *evalError) set(err error) {
+	e.mu.Lock()
+	if e.err == nil {
+		e.err = err
+	}
+	e.mu.Unlock()
+}
+
+func (e *evalError) get() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+// arithmetic returns the function that computes the value a filter
+// like total = qty * price compares to from the values of its
+// ValueColumn and ValueOperand. Integers give integers, except with /,
+// which gives a float64. A nil value gives no value, like a missing
+// one, and values that aren't numbers or a division by zero are
+// recorded in errs.
+func (e *Executor) arithmetic(f FilterDesc, errs *evalError) (func(a, b interface{}) (interface{}, bool), error) {
+	switch f.ValueOperator {
+	case "+", "-", "*", "/":
+	default:
+		return nil, fmt.Errorf("unknown arithmetic operator %s", f.ValueOperator)
+	}
+	expression := f.ValueColumn + " " + f.ValueOperator + " " + f.ValueOperand
+	coerceA, coerceB := e.coercion(f.ValueColumn), e.coercion(f.ValueOperand)
+
+	return func(a, b interface{}) (interface{}, bool) {
+		if a == nil || b == nil {
+			return nil, false
+		}
+		var ok bool
+		if a, ok = coerceA(a); !ok {
+			return nil, false
+		}
+		if b, ok = coerceB(b); !ok {
+			return nil, false
+		}
+		for _, v := range []interface{}{a, b} {
+			if _, ok := toFloat64(v); !ok {
+				errs.set(fmt.Errorf("query: %s: %s isn't a number", expression, formatValue(v)))
+				return nil, false
+			}
+		}
+
+		x, xInt := toInt64(a)
+		y, yInt := toInt64(b)
+		if xInt && yInt && f.ValueOperator != "/" {
+			switch f.ValueOperator {
+			case "+":
+				return x + y, true
+			case "-":
+				return x - y, true
+			default:
+				return x * y, true
+			}
+		}
+		fx, _ := toFloat64(a)
+		fy, _ := toFloat64(b)
+		switch f.ValueOperator {
+		case "+":
+			return fx + fy, true
+		case "-":
+			return fx - fy, true
+		case "*":
+			return fx * fy, true
+		}
+		if fy == 0 {
+			errs.set(fmt.Errorf("query: %s: division by zero", expression))
+			return nil, false
+		}
+		return fx / fy, true
+	}, nil
+}
+
+// coercion returns a function that casts values to the schema type of
+// column, or returns them as they are if it has none.
+func (e *Executor) coercion(column string) func(v interface{}) (interface{}, bool) {
+	if typ, ok := e.schema[column]; ok {
+		return coerceTo(typ)
+	}
+	return func(v interface{}) (interface{}, bool) {
+		return v, true
+	}
+}
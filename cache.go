@@ -0,0 +1,71 @@
+package query
+
+import (
+	"container/list"
+	"sync"
+)
+
+// ParserCache is a fixed-size LRU cache of parsed queries keyed by
+// query text. It's safe for concurrent use.
+type ParserCache struct {
+	size int
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // most recently used first
+}
+
+type parserCacheEntry struct {
+	text  string
+	query *Query
+}
+
+// NewParserCache returns a cache holding up to size parsed queries.
+func NewParserCache(size int) *ParserCache {
+	return &ParserCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// Parse is like the package-level Parse but returns a cached result
+// for query text it has parsed before. It returns a clone so callers
+// may modify it. Errors aren't cached.
+func (c *ParserCache) Parse(s string) (*Query, error) {
+	c.lock.Lock()
+	if e, ok := c.entries[s]; ok {
+		c.order.MoveToFront(e)
+		q := e.Value.(*parserCacheEntry).query
+		c.lock.Unlock()
+		return q.Clone(), nil
+	}
+	c.lock.Unlock()
+
+	q, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.size <= 0 {
+		return q, nil
+	}
+	if _, ok := c.entries[s]; !ok {
+		c.entries[s] = c.order.PushFront(&parserCacheEntry{text: s, query: q.Clone()})
+		for c.order.Len() > c.size {
+			oldest := c.order.Back()
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*parserCacheEntry).text)
+		}
+	}
+	return q, nil
+}
+
+// Len returns the number of cached queries.
+func (c *ParserCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestParserCache(t *testing.T) {
+	c := NewParserCache(2)
+
+	q, err := c.Parse("SELECT * WHERE a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Filters[0].Value = 2
+
+	q, err = c.Parse("SELECT * WHERE a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Filters[0].Value != 1 {
+		t.Errorf("modifying a result changed the cache: %v", q)
+	}
+
+	if _, err := c.Parse("SELECT * WHERE"); err == nil {
+		t.Error("expected an error")
+	}
+	if c.Len() != 1 {
+		t.Errorf("expected errors not to be cached, got %d entries", c.Len())
+	}
+
+	c.Parse("SELECT a")
+	c.Parse("SELECT * WHERE a = 1")
+	c.Parse("SELECT b")
+	if c.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", c.Len())
+	}
+	if _, ok := c.entries["SELECT a"]; ok {
+		t.Error("expected the least recently used query to be evicted")
+	}
+	if _, ok := c.entries["SELECT * WHERE a = 1"]; !ok {
+		t.Error("expected a recently used query to stay cached")
+	}
+}
+
+func TestParserCacheConcurrent(t *testing.T) {
+	c := NewParserCache(4)
+	queries := []string{"SELECT a", "SELECT b", "SELECT c", "SELECT d", "SELECT e"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s := queries[(i+j)%len(queries)]
+				q, err := c.Parse(s)
+				if err != nil || "SELECT "+q.Columns[0].Name != s {
+					t.Errorf("%s: got %v, %v", s, q, err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if c.Len() > 4 {
+		t.Errorf("expected at most 4 entries, got %d", c.Len())
+	}
+}
+
+func BenchmarkParserCache(b *testing.B) {
+	c := NewParserCache(16)
+	for n := 0; n < b.N; n++ {
+		c.Parse("SELECT a, b, min(c), sum(d) WHERE a < 1, b < 2, c < 3 GROUP BY a, b ORDER BY min(c) DESC LIMIT 10")
+	}
+}
+
+func TestParserCacheListValues(t *testing.T) {
+	c := NewParserCache(1)
+	query := "SELECT * WHERE a IN (1, 2) OR b BETWEEN 3 AND 4"
+	q, err := c.Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Filters[0].Or[0][0].Value.([]interface{})[0] = 5
+	q.Filters[0].Or[1][0].Value.([]interface{})[1] = 5
+
+	q, err = c.Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in := q.Filters[0].Or[0][0].Value; !reflect.DeepEqual(in, []interface{}{1, 2}) {
+		t.Errorf("modifying an IN list changed the cache: %v", in)
+	}
+	if between := q.Filters[0].Or[1][0].Value; !reflect.DeepEqual(between, []interface{}{3, 4}) {
+		t.Errorf("modifying BETWEEN bounds changed the cache: %v", between)
+	}
+}
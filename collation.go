@@ -0,0 +1,110 @@
+package query
+
+import (
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Collation options for WithCollation.
+type Collation int
+
+const (
+	// CaseInsensitive makes strings that only differ in case equal.
+	CaseInsensitive Collation = 1 << iota
+	// AccentInsensitive makes strings that only differ in accents
+	// equal, e.g. "resume" and "résumé".
+	AccentInsensitive
+)
+
+// WithCollation makes the =, !=, <, <=, >, and >= filters and ORDER BY
+// compare strings by the collation rules of locale, like "en_US" or
+// "de", instead of byte by byte. Other string filters, like
+// starts_with and matches, are unaffected. An unknown locale uses the
+// root collation.
+func WithCollation(locale string, options Collation) Option {
+	tag := language.Make(locale)
+	collateOptions := []collate.Option{}
+	if options&CaseInsensitive != 0 {
+		collateOptions = append(collateOptions, collate.IgnoreCase)
+	}
+	if options&AccentInsensitive != 0 {
+		collateOptions = append(collateOptions, collate.IgnoreDiacritics)
+	}
+
+	// Collators and transformers aren't safe for concurrent use.
+	collators := &sync.Pool{
+		New: func() interface{} {
+			c := &collator{Collator: collate.New(tag, collateOptions...)}
+			if options&AccentInsensitive != 0 {
+				// Collators still distinguish accents at the tertiary
+				// level, which also has case, so accents are removed.
+				c.stripAccents = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+			}
+			return c
+		},
+	}
+	return func(e *Executor) {
+		e.collate = func(s string) string {
+			c := collators.Get().(*collator)
+			defer collators.Put(c)
+			if c.stripAccents != nil {
+				s, _, _ = transform.String(c.stripAccents, s)
+			}
+			c.buf.Reset()
+			return string(c.KeyFromString(&c.buf, s))
+		}
+	}
+}
+
+type collator struct {
+	*collate.Collator
+	buf          collate.Buffer
+	stripAccents transform.Transformer
+}
+
+// collated reports whether filters of type t compare strings by the
+// executor's collation.
+func collated(t FilterType) bool {
+	switch t {
+	case FilterEquals, FilterNotEquals, FilterIn, FilterBetween, FilterNotBetween, FilterLessThan, FilterLessThanOrEqual,
+		FilterGreaterThan, FilterGreaterThanOrEqual:
+		return true
+	}
+	return false
+}
+
+// collateValue replaces strings in v with their collation keys, which
+// compare byte by byte like the strings compare by the collation.
+func collateValue(v interface{}, key func(string) string) interface{} {
+	switch v := v.(type) {
+	case string:
+		return key(v)
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i := range v {
+			values[i] = collateValue(v[i], key)
+		}
+		return values
+	}
+	return v
+}
+
+// collateStrings returns a filter function that replaces string values
+// with their collation keys after applying fn, if fn isn't nil.
+func collateStrings(fn func(v interface{}) (interface{}, bool), key func(string) string) func(v interface{}) (interface{}, bool) {
+	return func(v interface{}) (interface{}, bool) {
+		if fn != nil {
+			var ok bool
+			if v, ok = fn(v); !ok {
+				return nil, false
+			}
+		}
+		return collateValue(v, key), true
+	}
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithCollation(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "résumé"},
+		{"id": 2, "name": "Resume"},
+		{"id": 3, "name": "resume"},
+		{"id": 4, "name": "Zoë"},
+		{"id": 5, "name": "äpfel"},
+		{"id": 6, "name": 7},
+	}
+
+	cases := []struct {
+		options  Collation
+		query    string
+		expected []interface{}
+	}{
+		{0, `SELECT * WHERE name = "resume"`, []interface{}{3}},
+		{CaseInsensitive, `SELECT * WHERE name = "RESUME"`, []interface{}{2, 3}},
+		{AccentInsensitive, `SELECT * WHERE name = "resume"`, []interface{}{1, 3}},
+		{CaseInsensitive | AccentInsensitive, `SELECT * WHERE name = "resume"`, []interface{}{1, 2, 3}},
+		{CaseInsensitive | AccentInsensitive, `SELECT * WHERE name != "resume" | "zoe"`, []interface{}{5, 6}},
+		// Byte by byte, "äpfel" sorts after "z".
+		{0, `SELECT * WHERE name < "b"`, []interface{}{5}},
+		{CaseInsensitive, `SELECT * WHERE name >= "zoe", name < "zz"`, []interface{}{4}},
+		// Other string filters don't use the collation.
+		{CaseInsensitive, `SELECT * WHERE name starts_with "res"`, []interface{}{3}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table, WithCollation("en_US", c.options)).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s (%d): expected %v, got %v", c.query, c.options, c.expected, ids)
+		}
+	}
+
+	checkIDs(t, table, `SELECT * WHERE name = "Resume"`, 2)
+}
@@ -0,0 +1,90 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is the error the parse functions return for invalid
+// syntax.
+type ParseError interface {
+	error
+
+	// Position returns the line and column, counting from 1, where
+	// parsing stopped. Columns count characters, not bytes.
+	Position() (line, column int)
+
+	// Rule returns the name of the grammar rule of the token parsing
+	// stopped at, like "FilterValue".
+	Rule() string
+
+	// DetailedError describes the error with the line of the query
+	// where parsing stopped and a caret under the column.
+	DetailedError() string
+}
+
+var _ ParseError = (*parseError)(nil)
+
+func (e *parseError) Position() (line, column int) {
+	buffer := e.p.buffer
+	if n := len(buffer); n > 0 && buffer[n-1] == endSymbol {
+		buffer = buffer[:n-1]
+	}
+	lines := strings.Split(string(buffer), "\n")
+
+	position := int(e.max.end)
+	line, column = len(lines), len([]rune(lines[len(lines)-1]))+1
+	if position < len(buffer) {
+		translated := translatePositions(e.p.buffer, []int{position})[position]
+		line, column = translated.line, translated.symbol
+		if buffer[position] == '\n' {
+			// The error is at the end of the previous line.
+			line--
+			column = len([]rune(lines[line-1])) + 1
+		}
+	}
+	return line, column
+}
+
+func (e *parseError) Rule() string {
+	return rul3s[e.max.pegRule]
+}
+
+// DetailedError describes the parse error with the line of the query
+// where parsing stopped and a caret under the column, like:
+//
+//	parse error at line 2, column 9:
+//	WHERE a = = 1
+//	          ^
+func (e *parseError) DetailedError() string {
+	line, column := e.Position()
+	text := strings.Split(string(e.p.buffer), "\n")[line-1]
+	text = strings.TrimSuffix(text, string(endSymbol))
+	caret := []rune{}
+	for i, r := range []rune(text) {
+		if i >= column-1 {
+			break
+		}
+		if r == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+	return fmt.Sprintf("parse error at line %d, column %d:\n%s\n%s^", line, column, text, string(caret))
+}
+
+// UnsupportedError is returned for queries using a feature the executor
+// can't run, like "order by" for Stream. It matches ErrUnsupported with
+// errors.Is.
+type UnsupportedError struct {
+	Feature string
+}
+
+func (e *UnsupportedError) Error() string {
+	return ErrUnsupported.Error() + ": " + e.Feature
+}
+
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupported
+}
@@ -1,12 +1,23 @@
 package query
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
-	ErrUnsupported = errors.New("query: unsupported query")
+	ErrUnsupported      = errors.New("query: unsupported query")
+	ErrLimitRequired    = errors.New("query: LIMIT is required")
+	ErrClauseNotAllowed = errors.New("query: clause not allowed")
+	ErrNoRows           = errors.New("query: no rows")
 )
 
 type Table interface {
@@ -25,7 +36,9 @@ type Row interface {
 }
 
 type Result struct {
-	rows []resultRow
+	columns []ColumnDesc
+	groupBy []ColumnDesc
+	rows    []resultRow
 }
 
 func (res *Result) Rows() []Row {
@@ -36,15 +49,137 @@ func (res *Result) Rows() []Row {
 	return rows
 }
 
+// MarshalJSON encodes the result as an array of its rows, each an
+// object with its fields in the order of the query's columns.
+func (res *Result) MarshalJSON() ([]byte, error) {
+	rows := res.rows
+	if rows == nil {
+		rows = []resultRow{}
+	}
+	return json.Marshal(rows)
+}
+
+// ColumnInfo describes a column of a result.
+type ColumnInfo struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ColumnInfo returns the output columns of the result along with
+// their types: "int", "float", "string", "bool", "null" (no non-nil
+// values), "mixed", or "unknown". The types of count(), count_if(),
+// avg(), stddev(), variance(), and corr() columns come from the
+// aggregate. Other types are observed rather than declared: they're
+// inferred from the values in the result rows, so they can change with
+// the data, and they're "null" for an empty result.
+// A "*" column expands to every field seen in the result, sorted by
+// name. Columns selected more than once are only returned once.
+func (res *Result) ColumnInfo() []ColumnInfo {
+	columns := []ColumnInfo{}
+	for _, c := range res.columns {
+		if c.Name != "*" || c.Aggregate != "" {
+			columns = append(columns, ColumnInfo{Name: columnName(c), Type: aggregateTypes[c.Aggregate]})
+			continue
+		}
+		fields := map[string]bool{}
+		for _, r := range res.rows {
+			for field := range r.values {
+				fields[field] = true
+			}
+		}
+		expanded := []string{}
+		for field := range fields {
+			expanded = append(expanded, field)
+		}
+		sort.Strings(expanded)
+		for _, field := range expanded {
+			columns = append(columns, ColumnInfo{Name: field})
+		}
+	}
+
+	info := []ColumnInfo{}
+	added := map[string]bool{}
+	for _, c := range columns {
+		if added[c.Name] {
+			continue
+		}
+		added[c.Name] = true
+		if c.Type == "" {
+			for _, r := range res.rows {
+				v, ok := r.values[c.Name]
+				if !ok {
+					continue
+				}
+				c.Type = mergeTypes(c.Type, typeName(v))
+			}
+		}
+		if c.Type == "" {
+			c.Type = "null"
+		}
+		info = append(info, c)
+	}
+	return info
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "int"
+	case float32, float64, json.Number:
+		return "float"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	}
+	return "unknown"
+}
+
+func mergeTypes(a, b string) string {
+	switch {
+	case a == "" || a == "null":
+		return b
+	case b == "null" || a == b:
+		return a
+	case a == "int" && b == "float", a == "float" && b == "int":
+		return "float"
+	}
+	return "mixed"
+}
+
+// A resultRow is a row of a result. fields is the order its fields
+// were set in, which follows the query's columns.
 type resultRow struct {
 	values map[string]interface{}
+	fields []string
+}
+
+func newRow() resultRow {
+	return resultRow{values: map[string]interface{}{}}
+}
+
+// set sets a field, keeping its position if it's already set.
+func (r *resultRow) set(field string, v interface{}) {
+	if _, ok := r.values[field]; !ok {
+		r.fields = append(r.fields, field)
+	}
+	r.values[field] = v
 }
 
+// Fields returns the row's fields in the order of the query's columns,
+// with the fields of a "*" sorted by name. Rows built without an order
+// have their fields sorted.
 func (r resultRow) Fields() []string {
+	if len(r.fields) == len(r.values) {
+		return append([]string{}, r.fields...)
+	}
 	fields := []string{}
 	for field := range r.values {
 		fields = append(fields, field)
 	}
+	sort.Strings(fields)
 	return fields
 }
 
@@ -53,78 +188,852 @@ func (r resultRow) Get(field string) (interface{}, bool) {
 	return v, ok
 }
 
+// key returns a key identifying the row's fields and values.
+func (r resultRow) key() string {
+	fields := r.Fields()
+	sort.Strings(fields)
+	values := []interface{}{}
+	for _, field := range fields {
+		values = append(values, field, r.values[field])
+	}
+	return fmt.Sprintf("%#v", values)
+}
+
+// MarshalJSON encodes the row as an object with its fields in the
+// order of Fields.
 func (r resultRow) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.values)
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range r.Fields() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(r.values[field])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
 // Executor is a query executor.
 type Executor struct {
 	table Table
+
+	requireLimit      bool
+	defaultLimit      int
+	maxLimit          int
+	disallowedClauses []Clause
+	clock             func() time.Time
+	observer          func(ExecStats)
+	normalize         func(string) string
+	collate           func(string) string
+	concurrency       int
+	schema            Schema
+	keepMissing       bool
+	defaultDescending bool
+	nullPolicies      map[string]NullPolicy
+}
+
+// ExecStats describes an execution of a query.
+type ExecStats struct {
+	// RowsScanned is the number of rows read from the table.
+	RowsScanned int
+	// RowsMatched is the number of rows that matched the filters.
+	RowsMatched int
+	// RowsReturned is the number of rows in the result.
+	RowsReturned int
+	// RowsFiltered is the number of rows rejected by each filter of
+	// the query, in order. It's only set by ExecuteAnalyze.
+	RowsFiltered []int
+	// GroupDuration is the time spent adding rows to groups and
+	// building the groups' rows, and SortDuration the time spent
+	// sorting for ORDER BY. They're only set by ExecuteAnalyze.
+	GroupDuration time.Duration
+	SortDuration  time.Duration
+	// PeakRowsBuffered is the most rows, or groups, held in memory at
+	// once to build the result. It's only set by ExecuteAnalyze.
+	PeakRowsBuffered int
+	// Duration is the time the whole execution took.
+	Duration time.Duration
+	// Err is the error returned by Execute, if any.
+	Err error
+}
+
+// An Option configures an Executor.
+type Option func(*Executor)
+
+// WithDefaultLimit sets the limit for queries without a LIMIT clause.
+// It doesn't apply to LIMIT ALL.
+func WithDefaultLimit(limit int) Option {
+	return func(e *Executor) {
+		e.defaultLimit = limit
+	}
 }
 
-func NewExecutor(table Table) *Executor {
-	return &Executor{
+// WithMaxLimit caps the limit of every query, including queries with
+// LIMIT ALL or no LIMIT.
+func WithMaxLimit(limit int) Option {
+	return func(e *Executor) {
+		e.maxLimit = limit
+	}
+}
+
+// A Clause is a part of a query that can be disallowed with
+// WithDisallowClauses.
+type Clause string
+
+const (
+	ClauseWhere   Clause = "WHERE"
+	ClauseGroupBy Clause = "GROUP BY"
+	ClauseOrderBy Clause = "ORDER BY"
+	ClauseLimit   Clause = "LIMIT"
+	ClauseOffset  Clause = "OFFSET"
+)
+
+// WithRequireLimit makes the executor reject queries without a LIMIT
+// with ErrLimitRequired. LIMIT ALL doesn't count as a limit.
+func WithRequireLimit() Option {
+	return func(e *Executor) {
+		e.requireLimit = true
+	}
+}
+
+// WithDisallowClauses makes the executor reject queries using any of
+// the given clauses with an error wrapping ErrClauseNotAllowed.
+func WithDisallowClauses(clauses ...Clause) Option {
+	return func(e *Executor) {
+		e.disallowedClauses = append(e.disallowedClauses, clauses...)
+	}
+}
+
+// WithClock sets the function the executor uses to get the current
+// time, e.g. to resolve now() in filters. The default is time.Now.
+func WithClock(clock func() time.Time) Option {
+	return func(e *Executor) {
+		e.clock = clock
+	}
+}
+
+// WithObserver sets a function that's called with the stats of every
+// query the executor runs, when Execute returns.
+func WithObserver(observer func(ExecStats)) Option {
+	return func(e *Executor) {
+		e.observer = observer
+	}
+}
+
+// WithUnicodeNormalization makes filters normalize string values from
+// rows and string filter values to the given form before comparing
+// them, so that e.g. a composed "é" equals "e" followed by a combining
+// accent. Strings aren't normalized by default.
+func WithUnicodeNormalization(form norm.Form) Option {
+	return func(e *Executor) {
+		e.normalize = form.String
+	}
+}
+
+// WithKeepMissingColumns makes filters match rows that don't have the
+// columns they compare, instead of dropping them. IS NULL and IS NOT
+// NULL are unaffected since they already decide what missing columns
+// mean.
+func WithKeepMissingColumns() Option {
+	return func(e *Executor) {
+		e.keepMissing = true
+	}
+}
+
+// WithDefaultOrderDescending makes ORDER BY sort in descending order
+// unless the query has an explicit ASC. DESC is descending either way.
+func WithDefaultOrderDescending() Option {
+	return func(e *Executor) {
+		e.defaultDescending = true
+	}
+}
+
+func NewExecutor(table Table, options ...Option) *Executor {
+	e := &Executor{
 		table: table,
+		clock: time.Now,
 	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
+}
+
+// checkPolicy returns an error if the query uses a feature forbidden by
+// the executor's options.
+func (e *Executor) checkPolicy(query *Query) error {
+	for _, clause := range e.disallowedClauses {
+		used := false
+		switch clause {
+		case ClauseWhere:
+			used = len(query.Filters) > 0
+		case ClauseGroupBy:
+			used = len(query.GroupBy) > 0
+		case ClauseOrderBy:
+			used = len(query.OrderBy) > 0
+		case ClauseLimit:
+			used = query.Limit > 0 || query.LimitAll
+		case ClauseOffset:
+			used = query.Offset > 0
+		}
+		if used {
+			return fmt.Errorf("%w: %s", ErrClauseNotAllowed, clause)
+		}
+	}
+	if e.requireLimit && query.Limit == 0 {
+		return ErrLimitRequired
+	}
+	return nil
+}
+
+// limit returns the number of rows to return for the query, or 0 for
+// no limit.
+func (e *Executor) limit(query *Query) int {
+	limit := query.Limit
+	if limit == 0 && !query.LimitAll {
+		limit = e.defaultLimit
+	}
+	if e.maxLimit > 0 && (limit == 0 || limit > e.maxLimit) {
+		limit = e.maxLimit
+	}
+	return limit
 }
 
 // Execute executes a query and returns a set of rows for the result.
+// Without an ORDER BY, the groups of a grouped query are sorted by their
+// GROUP BY values, in the order compareValues gives, so the result is
+// the same every time.
 func (e *Executor) Execute(query *Query) (*Result, error) {
-	// Get a cursor
-	var cur Cursor
-	var err error
-	if len(query.Columns) == 1 && query.Columns[0].Name == "*" && len(query.GroupBy) == 0 {
-		// SELECT * without GROUP BY
-		cur, err = e.table.NewCursor()
+	res, _, err := e.execute(query, nil, 0, nil)
+	return res, err
+}
+
+// ExecuteAnalyze executes a query like Execute and also returns
+// statistics about the execution, including how many rows each filter
+// rejected and the time spent grouping and sorting. The statistics are
+// returned even if execution fails.
+func (e *Executor) ExecuteAnalyze(query *Query) (*Result, *ExecStats, error) {
+	stats := &ExecStats{}
+	res, _, err := e.execute(query, nil, 0, stats)
+	return res, stats, err
+}
+
+// execute executes a query. If start is set, the table is read in
+// order, the result starts at the position of start, and next is the
+// position after the result if it has rows after the limit. If limit
+// isn't 0, it replaces the query's limit after the executor's policy is
+// checked. If analyze is set, it's filled in with the statistics of the
+// execution, including the number of rows each filter rejected.
+func (e *Executor) execute(query *Query, start *pageToken, limit int, analyze *ExecStats) (res *Result, next *pageToken, err error) {
+	stats := analyze
+	if stats == nil {
+		stats = &ExecStats{}
+	}
+	if e.observer != nil || analyze != nil {
+		start := e.clock()
+		defer func() {
+			stats.Duration = e.clock().Sub(start)
+			stats.Err = err
+			if e.observer != nil {
+				e.observer(*stats)
+			}
+		}()
+	}
+
+	query, filters, err := e.prepare(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if analyze != nil {
+		rejected := make([]int64, len(filters))
+		filters = countRejections(filters, rejected)
+		defer func() {
+			stats.RowsFiltered = make([]int, len(rejected))
+			for i := range rejected {
+				stats.RowsFiltered[i] = int(atomic.LoadInt64(&rejected[i]))
+			}
+		}()
+	}
+	if limit == 0 {
+		limit = e.limit(query)
+	}
+	page := start != nil
+	if start == nil {
+		start = &pageToken{}
+	}
+	// A page of an ORDER BY query starts after the ORDER BY values of
+	// the previous page's last row, not at an offset.
+	offset := start.Offset
+	if start.After == nil {
+		offset += query.Offset
+	}
+	descending := query.Descending || e.defaultDescending && !query.Ascending
+	// last and lastCount are the ORDER BY values of the last row
+	// returned and the number of rows returned with those values,
+	// counting previous pages.
+	last, lastCount := start.After, start.Skip
+	more := false
+
+	// seen holds the DISTINCT ON keys of the rows returned so far.
+	// The first row with each key wins.
+	seen := map[string]bool{}
+	skipped, skippedAfter := 0, 0
+
+	// Grouped rows only have the selected columns already, in order.
+	project := func(row Row) resultRow {
+		return newResultRow(row, query.Columns)
+	}
+	if query.grouped() {
+		project = func(row Row) resultRow {
+			return row.(resultRow)
+		}
+	}
+	resultRows := []resultRow{}
+	emit := func(curRow Row, key []interface{}) bool {
+		if len(query.DistinctOn) > 0 {
+			key := distinctKey(curRow, query.DistinctOn)
+			if seen[key] {
+				return true
+			}
+			seen[key] = true
+		}
+		row := project(curRow)
+		if query.Distinct {
+			key := row.key()
+			if seen[key] {
+				return true
+			}
+			seen[key] = true
+		}
+
+		if start.After != nil {
+			// Rows with the same values as the last row of the
+			// previous page come in the same order, so the first
+			// Skip of them were on previous pages.
+			c := e.compareKeys(key, start.After, descending)
+			if c < 0 || c == 0 && skippedAfter < start.Skip {
+				if c == 0 {
+					skippedAfter++
+				}
+				return true
+			}
+		} else if skipped < offset {
+			skipped++
+			return true
+		}
+		if limit > 0 && len(resultRows) == limit {
+			more = true
+			return false
+		}
+		resultRows = append(resultRows, row)
+		if key != nil {
+			if last != nil && e.compareKeys(key, last, descending) == 0 {
+				lastCount++
+			} else {
+				last, lastCount = key, 1
+			}
+		}
+		return limit == 0 || len(resultRows) < limit || page
+	}
+
+	// Grouped rows are only emitted once the whole table is read.
+	var groups *grouper
+	if query.grouped() {
+		groups, err = e.newGrouper(query)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-	} else {
-		return nil, ErrUnsupported
+	}
+	// timed runs fn, adding the time it takes to d if the execution
+	// is analyzed.
+	timed := func(d *time.Duration, fn func()) {
+		if analyze == nil {
+			fn()
+			return
+		}
+		start := e.clock()
+		fn()
+		*d += e.clock().Sub(start)
 	}
 
-	switch {
-	case len(query.GroupBy) > 0, len(query.OrderBy) > 0:
-		return nil, ErrUnsupported
-	case len(query.Columns) > 0:
-		for _, c := range query.Columns {
-			if c.Aggregate != "" {
-				return nil, ErrUnsupported
+	var groupErr error
+	// ordered holds the rows to sort for an ORDER BY without groups.
+	ordered := []resultRow{}
+	match := func(curRow Row) bool {
+		stats.RowsMatched++
+		if groups != nil {
+			timed(&stats.GroupDuration, func() {
+				groupErr = groups.add(curRow)
+			})
+			return groupErr == nil
+		}
+		if len(query.OrderBy) > 0 {
+			ordered = append(ordered, newResultRow(curRow, nil))
+			return true
+		}
+		return emit(curRow, nil)
+	}
+
+	// Rows from different shards have no order, so the first row for
+	// a DISTINCT ON key, the rows of a page, or the order of rows with
+	// equal ORDER BY values would be arbitrary. Groups are sorted, so
+	// their order doesn't depend on the shards.
+	sharded := groups != nil || len(query.DistinctOn) == 0 && !page && len(query.OrderBy) == 0
+	rowsNeeded := 0
+	if limit > 0 {
+		rowsNeeded = offset + limit
+		if page {
+			rowsNeeded++
+		}
+	}
+	stats.RowsScanned, err = e.scan(context.Background(), filters, match, sharded, cursorLimit(query, filters, rowsNeeded))
+	if err == nil {
+		err = groupErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if groups != nil || len(query.OrderBy) > 0 {
+		rows := ordered
+		var keys [][]interface{}
+		if groups != nil {
+			timed(&stats.GroupDuration, func() {
+				rows, keys = groups.rows()
+			})
+		} else {
+			keys = sortKeys(rows, query.OrderBy)
+		}
+		if analyze != nil {
+			stats.PeakRowsBuffered = len(rows)
+		}
+		if len(query.OrderBy) > 0 {
+			timed(&stats.SortDuration, func() {
+				e.sortRows(rows, keys, descending)
+			})
+		}
+		for i, row := range rows {
+			var key []interface{}
+			if len(query.OrderBy) > 0 {
+				key = keys[i]
 			}
+			if !emit(row, key) {
+				break
+			}
+		}
+	}
+
+	if analyze != nil && len(resultRows) > stats.PeakRowsBuffered {
+		stats.PeakRowsBuffered = len(resultRows)
+	}
+	stats.RowsReturned = len(resultRows)
+	if more {
+		if len(query.OrderBy) > 0 {
+			next = &pageToken{After: last, Skip: lastCount}
+		} else {
+			next = &pageToken{Offset: start.Offset + len(resultRows)}
 		}
 	}
+	return &Result{columns: query.Columns, groupBy: query.GroupBy, rows: resultRows}, next, nil
+}
 
-	filters, err := buildFilters(query.Filters)
+// prepare checks that the query can be executed and builds its
+// filters. It returns the query to execute, which is a copy with the
+// schema applied if the executor has one.
+func (e *Executor) prepare(query *Query) (*Query, []Filter, error) {
+	query = query.resolveAliases()
+	if err := query.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if e.schema != nil {
+		query = query.Clone()
+		if err := e.schema.Apply(query); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := e.checkPolicy(query); err != nil {
+		return nil, nil, err
+	}
+
+	for _, c := range query.OrderBy {
+		if c.Aggregate != "" && !selected(query.Columns, c) {
+			return nil, nil, fmt.Errorf("query: ORDER BY %s must also be selected", columnName(c))
+		}
+	}
+	for _, c := range query.GroupBy {
+		if c.Aggregate != "" {
+			return nil, nil, &UnsupportedError{Feature: "group by " + c.Aggregate + "()"}
+		}
+	}
+	for _, c := range query.Columns {
+		if c.Aggregate == "" {
+			continue
+		}
+		newAggregator, ok := aggregates[c.Aggregate]
+		if !ok {
+			return nil, nil, fmt.Errorf("query: unknown aggregate %s()", c.Aggregate)
+		}
+		if newAggregator == nil {
+			return nil, nil, &UnsupportedError{Feature: c.Aggregate + "()"}
+		}
+	}
+
+	filters, err := e.buildFilters(query.Filters)
 	if err != nil {
+		return nil, nil, err
+	}
+	return query, filters, nil
+}
+
+// selected reports whether c is one of columns.
+func selected(columns []ColumnDesc, c ColumnDesc) bool {
+	for _, column := range columns {
+		if formatColumn(column) == formatColumn(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan returns a copy of the query as the executor would run it,
+// without running it: the schema is applied, now() is resolved,
+// strings are normalized, and the limit is the effective limit. It
+// returns the same errors as Execute for queries that can't be run.
+func (e *Executor) Plan(query *Query) (*Query, error) {
+	if _, _, err := e.prepare(query); err != nil {
 		return nil, err
 	}
 
-	resultRows := []resultRow{}
-CursorLoop:
-	for cur.Next() {
-		for _, f := range filters {
-			if !f.Filter(cur.Row()) {
-				continue CursorLoop
+	plan := query.Clone()
+	if e.schema != nil {
+		if err := e.schema.Apply(plan); err != nil {
+			return nil, err
+		}
+	}
+	e.resolveFilters(plan.Filters)
+	plan.Limit = e.limit(query)
+	if plan.Limit > 0 {
+		plan.LimitAll = false
+	}
+	return plan, nil
+}
+
+// resolveFilters resolves the values of filters, including those in the
+// alternatives of ORs.
+func (e *Executor) resolveFilters(filters []FilterDesc) {
+	for i := range filters {
+		for _, alternative := range filters[i].Or {
+			e.resolveFilters(alternative)
+		}
+		filters[i].Value = e.resolveValue(filters[i].Value)
+	}
+}
+
+// Stream executes a query like Execute, but sends the rows of the
+// result on a channel as they're found. The rows channel is closed
+// when the query is done, and then the error channel receives the
+// error, if any, and is closed. Canceling ctx stops the query with
+// ctx's error. Queries with ORDER BY, GROUP BY, or aggregates aren't
+// supported.
+func (e *Executor) Stream(ctx context.Context, query *Query) (<-chan Row, <-chan error) {
+	rows := make(chan Row)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(rows)
+
+		err := e.stream(ctx, query, func(row Row) bool {
+			select {
+			case rows <- row:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return rows, errs
+}
+
+// ExecuteFunc executes a query like Execute, but calls fn with each row
+// of the result as it's found instead of collecting them. It stops and
+// returns fn's error if fn returns one. fn isn't called concurrently.
+// Queries with ORDER BY, GROUP BY, or aggregates aren't supported.
+func (e *Executor) ExecuteFunc(query *Query, fn func(Row) error) error {
+	var fnErr error
+	err := e.stream(context.Background(), query, func(row Row) bool {
+		fnErr = fn(row)
+		return fnErr == nil
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	return err
+}
+
+// stream executes a query whose rows don't have to be buffered,
+// calling send with each row of the result until it returns false.
+func (e *Executor) stream(ctx context.Context, query *Query, send func(Row) bool) error {
+	query, filters, err := e.prepare(query)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(query.GroupBy) > 0:
+		return &UnsupportedError{Feature: "group by"}
+	case query.grouped():
+		return &UnsupportedError{Feature: "aggregates"}
+	case len(query.OrderBy) > 0:
+		return &UnsupportedError{Feature: "order by"}
+	}
+	limit := e.limit(query)
+
+	seen := map[string]bool{}
+	skipped, sent := 0, 0
+	match := func(curRow Row) bool {
+		if len(query.DistinctOn) > 0 {
+			key := distinctKey(curRow, query.DistinctOn)
+			if seen[key] {
+				return true
+			}
+			seen[key] = true
+		}
+		row := newResultRow(curRow, query.Columns)
+		if query.Distinct {
+			key := row.key()
+			if seen[key] {
+				return true
 			}
+			seen[key] = true
+		}
+		if skipped < query.Offset {
+			skipped++
+			return true
+		}
+
+		if !send(row) {
+			return false
+		}
+		sent++
+		return limit == 0 || sent < limit
+	}
+
+	rowsNeeded := 0
+	if limit > 0 {
+		rowsNeeded = query.Offset + limit
+	}
+	_, err = e.scan(ctx, filters, match, len(query.DistinctOn) == 0, cursorLimit(query, filters, rowsNeeded))
+	return err
+}
+
+// ExecuteOne executes a query and returns the first row of its result,
+// or ErrNoRows if it has none. It stops reading the table once it has a
+// row, unless the query is grouped or has an ORDER BY, in which case
+// it's executed with a limit of 1.
+func (e *Executor) ExecuteOne(query *Query) (Row, error) {
+	if query.grouped() || len(query.OrderBy) > 0 {
+		res, _, err := e.execute(query, nil, 1, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.rows) == 0 {
+			return nil, ErrNoRows
+		}
+		return res.rows[0], nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rows, errs := e.Stream(ctx, query)
+	if row, ok := <-rows; ok {
+		return row, nil
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return nil, ErrNoRows
+}
+
+// Count returns the number of rows matching the query's WHERE filters
+// without building result rows. The query's columns, GROUP BY, and
+// ORDER BY are ignored, rows before its OFFSET aren't counted, and its
+// LIMIT, as Execute would apply it, caps the count. The query is
+// checked and prepared like for Execute.
+func (e *Executor) Count(query *Query) (int, error) {
+	query, filters, err := e.prepare(query)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsNeeded := 0
+	if limit := e.limit(query); limit > 0 {
+		rowsNeeded = query.Offset + limit
+	}
+	count := 0
+	match := func(Row) bool {
+		count++
+		return rowsNeeded == 0 || count < rowsNeeded
+	}
+	if _, err := e.scan(context.Background(), filters, match, true, cursorLimit(query, filters, rowsNeeded)); err != nil {
+		return 0, err
+	}
+
+	if count < query.Offset {
+		return 0, nil
+	}
+	return count - query.Offset, nil
+}
+
+// A LimitedTable is a Table that can return a cursor over at most n
+// rows, e.g. to read less of an index. The executor uses it when it
+// only needs the first n rows of the table.
+type LimitedTable interface {
+	Table
+	NewCursorWithLimit(n int) (Cursor, error)
+}
+
+// cursorLimit returns the number of rows of the table needed to return
+// n rows of the query's result, or 0 if it's unknown because rows may
+// be filtered out, grouped, or sorted.
+func cursorLimit(query *Query, filters []Filter, n int) int {
+	if len(filters) > 0 || query.Distinct || len(query.DistinctOn) > 0 || len(query.OrderBy) > 0 || query.grouped() {
+		return 0
+	}
+	return n
+}
+
+// scan reads the table and calls match for every row that passes the
+// filters until match returns false or ctx is canceled. If sharded is
+// true and the table is a ShardedTable, its shards are scanned
+// concurrently, but match is never called concurrently. If limit isn't
+// 0 and the table is a LimitedTable, only limit rows are read. scan
+// returns the number of rows read.
+func (e *Executor) scan(ctx context.Context, filters []Filter, match func(Row) bool, sharded bool, limit int) (int, error) {
+	if table, ok := e.table.(ShardedTable); ok && sharded {
+		cursors, err := table.Cursors()
+		if err != nil {
+			return 0, err
 		}
+		return e.scanConcurrently(ctx, cursors, filters, match)
+	}
 
+	var cur Cursor
+	var err error
+	if table, ok := e.table.(LimitedTable); ok && limit > 0 {
+		cur, err = table.NewCursorWithLimit(limit)
+	} else {
+		cur, err = e.table.NewCursor()
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	scanned := 0
+	for cur.Next() {
+		if err := ctx.Err(); err != nil {
+			return scanned, err
+		}
+		scanned++
 		curRow := cur.Row()
-		resRow := resultRow{
-			values: map[string]interface{}{},
+		ok, err := passes(filters, curRow)
+		if err != nil {
+			return scanned, err
 		}
-		for _, field := range curRow.Fields() {
-			v, _ := curRow.Get(field)
-			resRow.values[field] = v
+		if !ok {
+			continue
 		}
-		resultRows = append(resultRows, resRow)
-		if query.Limit > 0 && len(resultRows) == query.Limit {
+		if !match(curRow) {
 			break
 		}
 	}
 
-	if cur.Err() != nil {
-		return nil, cur.Err()
+	return scanned, cur.Err()
+}
+
+// countRejections returns filters that behave like filters but count
+// the rows each one rejects in rejected. Filters are applied in order,
+// so a row is only counted for the first filter it fails.
+func countRejections(filters []Filter, rejected []int64) []Filter {
+	counted := make([]Filter, len(filters))
+	for i := range filters {
+		f, count := filters[i], &rejected[i]
+		counted[i] = Filter{errs: f.errs, row: func(r Row) bool {
+			if f.Filter(r) {
+				return true
+			}
+			atomic.AddInt64(count, 1)
+			return false
+		}}
+	}
+	return counted
+}
+
+// newResultRow copies the fields of row selected by columns into a
+// resultRow. A "*", or no columns at all, selects every field except
+// the ones named by other columns, so SELECT a AS id, * has a's value
+// as id. Selected columns the row doesn't have are left out, but
+// columns with a function, like bucket(latency, 100), are always
+// computed.
+func newResultRow(row Row, columns []ColumnDesc) resultRow {
+	resRow := newRow()
+	if len(columns) == 0 {
+		columns = []ColumnDesc{{Name: "*"}}
+	}
+	named := map[string]bool{}
+	for _, c := range columns {
+		if c.Name != "*" {
+			named[columnName(c)] = true
+		}
 	}
+	for _, c := range columns {
+		if c.Function != "" {
+			resRow.set(columnName(c), columnValue(c, row))
+			continue
+		}
+		if c.Name != "*" {
+			if v, ok := row.Get(c.Name); ok {
+				resRow.set(columnName(c), v)
+			}
+			continue
+		}
+		fields := row.Fields()
+		sort.Strings(fields)
+		for _, field := range fields {
+			if named[field] {
+				continue
+			}
+			v, _ := row.Get(field)
+			resRow.set(field, v)
+		}
+	}
+	return resRow
+}
 
-	return &Result{rows: resultRows}, nil
+// distinctKey returns a key identifying the row's values for columns.
+// Values of different types get different keys, and a missing column
+// gets the same key as a nil value.
+func distinctKey(row Row, columns []ColumnDesc) string {
+	values := []interface{}{}
+	for _, c := range columns {
+		values = append(values, columnValue(c, row))
+	}
+	return fmt.Sprintf("%#v", values)
 }
@@ -1,6 +1,20 @@
 package query
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
 
 var testData = []map[string]interface{}{
 	{"id": 1, "a": 1, "b": 2},
@@ -9,33 +23,69 @@ var testData = []map[string]interface{}{
 	{"id": 4, "a": 1, "b": 2},
 }
 
-type testDataCursor struct {
-	idx  int
-	data []map[string]interface{}
+type mapRow map[string]interface{}
+
+func (r mapRow) Fields() []string {
+	fields := []string{}
+	for field := range r {
+		fields = append(fields, field)
+	}
+	return fields
 }
 
-func (c *testDataCursor) Err() error {
-	return nil
+func (r mapRow) Get(field string) (interface{}, bool) {
+	v, ok := r[field]
+	return v, ok
 }
 
-func (c *testDataCursor) Next() bool {
-	c.idx++
-	if c.idx < len(c.data) {
-		return true
-	}
-	return false
+type testDataTable struct{}
+
+func (t testDataTable) NewCursor() (Cursor, error) {
+	return NewSliceTable(testData).NewCursor()
 }
 
-func (c *testDataCursor) Row() Row {
-	return resultRow{values: c.data[c.idx]}
+type testSliceTable []map[string]interface{}
+
+func (t testSliceTable) NewCursor() (Cursor, error) {
+	return NewSliceTable(t).NewCursor()
 }
 
-type mapRow map[string]interface{}
+var testNames = testSliceTable{
+	{"id": 1, "name": "John"},
+	{"id": 2, "name": "Johnson"},
+	{"id": 3, "name": "jolene"},
+	{"id": 4, "name": "Alison"},
+}
 
-type testDataTable struct{}
+func executeIDs(t *testing.T, table Table, query string, options ...Option) []interface{} {
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatal(query, err)
+	}
+	res, err := NewExecutor(table, options...).Execute(q)
+	if err != nil {
+		t.Fatal(query, err)
+	}
+	ids := []interface{}{}
+	for _, row := range res.Rows() {
+		id, _ := row.Get("id")
+		ids = append(ids, id)
+	}
+	return ids
+}
 
-func (t testDataTable) NewCursor() (Cursor, error) {
-	return &testDataCursor{idx: -1, data: testData}, nil
+func checkIDs(t *testing.T, table Table, query string, expected ...interface{}) {
+	ids := executeIDs(t, table, query)
+	if len(ids) != len(expected) {
+		t.Errorf("%s: expected ids %v, got %v", query, expected, ids)
+		return
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Errorf("%s: expected ids %v, got %v", query, expected, ids)
+			return
+		}
+	}
 }
 
 func TestExecutor(t *testing.T) {
@@ -49,3 +99,1611 @@ func TestExecutor(t *testing.T) {
 
 	t.Log(exec.Execute(q))
 }
+
+func TestResultColumnInfo(t *testing.T) {
+	cases := []struct {
+		table    Table
+		query    string
+		expected []ColumnInfo
+	}{
+		{testDataTable{}, "SELECT * WHERE id > 2", []ColumnInfo{
+			{Name: "a", Type: "int"},
+			{Name: "b", Type: "int"},
+			{Name: "id", Type: "int"},
+		}},
+		// Aggregates have their own types, whatever the values are.
+		{testGroups, "SELECT kind, count(*), avg(id) AS mean, sum(id) WHERE id = 8 GROUP BY kind", []ColumnInfo{
+			{Name: "kind", Type: "string"},
+			{Name: "count(*)", Type: "int"},
+			{Name: "mean", Type: "float"},
+			{Name: "sum(id)", Type: "int"},
+		}},
+		{testGroups, "SELECT kind, count(*), avg(id) WHERE id > 10 GROUP BY kind", []ColumnInfo{
+			{Name: "kind", Type: "null"},
+			{Name: "count(*)", Type: "int"},
+			{Name: "avg(id)", Type: "float"},
+		}},
+		{testNames, "SELECT id, name WHERE id > 10", []ColumnInfo{
+			{Name: "id", Type: "null"},
+			{Name: "name", Type: "null"},
+		}},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(c.table).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if info := res.ColumnInfo(); !reflect.DeepEqual(info, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, info)
+		}
+	}
+}
+
+func TestMergeTypes(t *testing.T) {
+	cases := []struct {
+		values   []interface{}
+		expected string
+	}{
+		{[]interface{}{1, 2}, "int"},
+		{[]interface{}{1, 2.5}, "float"},
+		{[]interface{}{nil, "a"}, "string"},
+		{[]interface{}{nil}, "null"},
+		{[]interface{}{"a", 1}, "mixed"},
+		{[]interface{}{true, false}, "bool"},
+	}
+
+	for _, c := range cases {
+		typ := ""
+		for _, v := range c.values {
+			typ = mergeTypes(typ, typeName(v))
+		}
+		if typ != c.expected {
+			t.Errorf("%v: expected %s, got %s", c.values, c.expected, typ)
+		}
+	}
+}
+
+func TestPrefixSuffixFilters(t *testing.T) {
+	checkIDs(t, testNames, `SELECT * WHERE name starts_with "Jo"`, 1, 2)
+	checkIDs(t, testNames, `SELECT * WHERE name ends_with "son"`, 2, 4)
+	checkIDs(t, testNames, `SELECT * WHERE name istarts_with "jo"`, 1, 2, 3)
+	checkIDs(t, testNames, `SELECT * WHERE name iends_with "SON"`, 2, 4)
+	checkIDs(t, testNames, `SELECT * WHERE id STARTS_WITH "3"`, 3)
+
+	q, err := Parse("SELECT * WHERE name starts_with 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(testNames).Execute(q); err == nil {
+		t.Error("expected an error for a non-string prefix")
+	}
+}
+
+func TestInCIDRFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "src_ip": "10.1.2.3"},
+		{"id": 2, "src_ip": "192.168.0.1"},
+		{"id": 3, "src_ip": "not an ip"},
+		{"id": 4, "src_ip": net.ParseIP("10.255.0.1")},
+		{"id": 5, "src_ip": "2001:db8::1"},
+		{"id": 6, "src_ip": 10},
+	}
+
+	checkIDs(t, table, `SELECT * WHERE src_ip in_cidr "10.0.0.0/8"`, 1, 4)
+	checkIDs(t, table, `SELECT * WHERE src_ip IN_CIDR "192.168.0.0/31"`, 2)
+	checkIDs(t, table, `SELECT * WHERE src_ip in_cidr "2001:db8::/32"`, 5)
+
+	for _, query := range []string{`SELECT * WHERE src_ip in_cidr "10.0.0.0"`, `SELECT * WHERE src_ip in_cidr 10`} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(table).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestLenFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "héllo", "tags": []string{"a", "b", "c", "d"}},
+		{"id": 2, "name": "", "tags": []interface{}{}},
+		{"id": 3, "tags": map[string]int{"x": 1}},
+		{"id": 4, "name": 42, "tags": nil},
+	}
+
+	checkIDs(t, table, "SELECT * WHERE len(name) = 5", 1)
+	checkIDs(t, table, "SELECT * WHERE len(name) = 0", 2)
+	checkIDs(t, table, "SELECT * WHERE len(tags) > 3", 1)
+	checkIDs(t, table, "SELECT * WHERE LEN(tags) <= 1", 2, 3)
+
+	q, err := Parse("SELECT * WHERE size(name) = 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table).Execute(q); err == nil {
+		t.Error("expected an error for an unknown function")
+	}
+}
+
+func TestJSONExtractFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "payload": map[string]interface{}{
+			"items": []interface{}{map[string]interface{}{"price": 12.5}},
+			"user":  map[string]string{"name": "alice"},
+		}},
+		{"id": 2, "payload": `{"items": [{"price": 3}, {"price": 20}], "user": {"name": "bob"}}`},
+		{"id": 3, "payload": map[string]interface{}{"items": []interface{}{}}},
+		{"id": 4, "payload": "not json"},
+	}
+
+	checkIDs(t, table, `SELECT * WHERE json_extract(payload, "items[0].price") > 10`, 1)
+	checkIDs(t, table, `SELECT * WHERE json_extract(payload, "items[0].price") < 10`, 2)
+	checkIDs(t, table, `SELECT * WHERE json_extract(payload, "$.items[1].price") >= 20`, 2)
+	checkIDs(t, table, `SELECT * WHERE json_extract(payload, "user.name") = "bob"`, 2)
+	checkIDs(t, table, `SELECT * WHERE json_extract(payload, "user.name") != "bob"`, 1)
+
+	for _, query := range []string{
+		`SELECT * WHERE json_extract(payload) = 1`,
+		`SELECT * WHERE json_extract(payload, "items[x]") = 1`,
+		`SELECT * WHERE json_extract(payload, "a..b") = 1`,
+		`SELECT * WHERE len(payload, "a") = 1`,
+	} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(table).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestFilterAlternatives(t *testing.T) {
+	checkIDs(t, testNames, `SELECT * WHERE name = "John" | "jolene" | "Bob"`, 1, 3)
+	checkIDs(t, testNames, `SELECT * WHERE name != "John" | "jolene"`, 2, 4)
+	checkIDs(t, testNames, `SELECT * WHERE id = 2 | 4 | "1"`, 2, 4)
+
+	_, err := NewExecutor(testNames).Execute(&Query{
+		Columns: []ColumnDesc{{Name: "*"}},
+		Filters: []FilterDesc{{Column: "id", Operator: "<", Value: []interface{}{1, 2}}},
+	})
+	if err == nil {
+		t.Error("expected an error for multiple values with <")
+	}
+}
+
+func TestSampleFilter(t *testing.T) {
+	table := testSliceTable{}
+	for i := 0; i < 10000; i++ {
+		table = append(table, map[string]interface{}{"id": i, "user_id": i % 100})
+	}
+
+	sample := func(query string) []interface{} {
+		ids := executeIDs(t, table, query)
+		if again := executeIDs(t, table, query); !reflect.DeepEqual(ids, again) {
+			t.Errorf("%s: expected the same rows every time", query)
+		}
+		return ids
+	}
+
+	if n := len(sample("SELECT * WHERE sample(10)")); n < 900 || n > 1100 {
+		t.Errorf("expected about 1000 rows, got %d", n)
+	}
+	if n := len(sample("SELECT * WHERE sample(0)")); n != 0 {
+		t.Errorf("expected no rows, got %d", n)
+	}
+	if n := len(sample("SELECT * WHERE sample(100)")); n != 10000 {
+		t.Errorf("expected every row, got %d", n)
+	}
+
+	// Sampling by user_id keeps all or none of each user's rows.
+	users := map[interface{}]int{}
+	for _, id := range sample("SELECT * WHERE sample(30, user_id)") {
+		users[id.(int)%100]++
+	}
+	for user, n := range users {
+		if n != 100 {
+			t.Errorf("user %v: expected 100 rows, got %d", user, n)
+		}
+	}
+	if len(users) < 15 || len(users) > 45 {
+		t.Errorf("expected about 30 users, got %d", len(users))
+	}
+}
+
+func TestQuantifiedFilters(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "scores": []int{95, 99}, "tags": []string{"go", "sql"}},
+		{"id": 2, "scores": []interface{}{80, 91.5}, "tags": []string{"golang"}},
+		{"id": 3, "scores": []int{}},
+		{"id": 4, "scores": 95},
+		{"id": 5},
+		{"id": 6, "scores": [2]int{10, 20}},
+	}
+
+	checkIDs(t, table, "SELECT * WHERE any(scores > 90)", 1, 2)
+	checkIDs(t, table, "SELECT * WHERE all(scores > 90)", 1, 3)
+	checkIDs(t, table, "SELECT * WHERE ALL ( scores < 90 )", 3, 6)
+	checkIDs(t, table, "SELECT * WHERE any(scores = 10 | 80)", 2, 6)
+	checkIDs(t, table, `SELECT * WHERE any(tags starts_with "go"), all(len(tags) <= 3)`, 1)
+	checkIDs(t, table, "SELECT * WHERE id > 1, any(scores >= 20)", 2, 6)
+
+	_, err := NewExecutor(table).Execute(&Query{
+		Columns: []ColumnDesc{{Name: "*"}},
+		Filters: []FilterDesc{{Operator: "sample", Value: 10, Quantifier: "any"}},
+	})
+	if err == nil {
+		t.Error("expected an error for a quantified sample filter")
+	}
+}
+
+func TestMatchesFilterSharesRegexps(t *testing.T) {
+	filters, err := NewExecutor(testNames).buildFilters([]FilterDesc{
+		{Column: "name", Operator: "matches", Value: "^J"},
+		{Column: "email", Operator: "matches", Value: "^J"},
+		{Column: "name", Operator: "matches", Value: "n$"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filters[0].value != filters[1].value {
+		t.Error("expected filters with the same pattern to share a regexp")
+	}
+	if filters[0].value == filters[2].value {
+		t.Error("expected filters with different patterns to have different regexps")
+	}
+
+	// The cache is shared with OR alternatives.
+	q, err := Parse(`SELECT * WHERE (name matches "^J", id > 1) OR (email matches "^J", id < 3) OR name matches "n$"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	regexps := map[string]*regexp.Regexp{}
+	if _, err := NewExecutor(testNames).buildFiltersWith(q.Filters, regexps, &evalError{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(regexps) != 2 {
+		t.Errorf("expected 2 compiled patterns, got %v", regexps)
+	}
+}
+
+func TestMatchesFilterAlternation(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE name matches "^Jo" OR name LIKE "%son" OR name ILIKE "ALI%"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filters, err := NewExecutor(testNames).buildFilters(q.Filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := filters[0].value.(*regexp.Regexp)
+	if !ok || r.String() != `(?:^Jo)|(?:(?s)^.*son$)|(?:(?i)(?s)^ALI.*$)` {
+		t.Fatalf("expected one alternation regexp, got %v", filters[0].value)
+	}
+	checkIDs(t, testNames, q.SQL(), 1, 2, 4)
+
+	// Alternatives on different columns or with other filters aren't
+	// combined.
+	for _, query := range []string{
+		`SELECT * WHERE name matches "^J" OR email matches "^J"`,
+		`SELECT * WHERE name matches "^J" OR name = "Alison"`,
+		`SELECT * WHERE name matches "^J" OR (name matches "n$", id > 1)`,
+	} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		filters, err := NewExecutor(testNames).buildFilters(q.Filters)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if filters[0].row == nil {
+			t.Errorf("%s: expected an OR filter", query)
+		}
+	}
+	checkIDs(t, testNames, `SELECT * WHERE name matches "^J" OR name = "Alison"`, 1, 2, 4)
+}
+
+func TestDistinctOn(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "user_id": 1, "kind": "a"},
+		{"id": 2, "user_id": 2, "kind": "a"},
+		{"id": 3, "user_id": 1, "kind": "b"},
+		{"id": 4, "user_id": "1", "kind": "a"},
+		{"id": 5, "kind": "a"},
+		{"id": 6, "user_id": nil, "kind": "b"},
+	}
+
+	checkIDs(t, table, "SELECT DISTINCT ON (user_id) *", 1, 2, 4, 5)
+	checkIDs(t, table, "SELECT DISTINCT ON (user_id, kind) *", 1, 2, 3, 4, 5, 6)
+	checkIDs(t, table, `SELECT DISTINCT ON (kind) * WHERE id > 1`, 2, 3)
+	checkIDs(t, table, "SELECT DISTINCT ON (user_id) * LIMIT 2", 1, 2)
+}
+
+func TestUnicodeNormalization(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "caf\u00e9"},
+		{"id": 2, "name": "cafe\u0301"},
+		{"id": 3, "name": "cafe"},
+	}
+
+	cases := []struct {
+		query    string
+		options  []Option
+		expected []interface{}
+	}{
+		{"SELECT * WHERE name = \"caf\u00e9\"", nil, []interface{}{1}},
+		{"SELECT * WHERE name = \"caf\u00e9\"", []Option{WithUnicodeNormalization(norm.NFC)}, []interface{}{1, 2}},
+		{"SELECT * WHERE name = \"cafe\u0301\"", []Option{WithUnicodeNormalization(norm.NFD)}, []interface{}{1, 2}},
+		{"SELECT * WHERE name ends_with \"\u00e9\"", []Option{WithUnicodeNormalization(norm.NFC)}, []interface{}{1, 2}},
+		{"SELECT * WHERE len(name) = 4", []Option{WithUnicodeNormalization(norm.NFC)}, []interface{}{1, 2, 3}},
+		{"SELECT * WHERE id = 2", []Option{WithUnicodeNormalization(norm.NFC)}, []interface{}{2}},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table, c.options...).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+}
+
+func TestCompareMixedTypes(t *testing.T) {
+	values := []interface{}{"a", "1", 1, int64(1), uint8(1), 1.0, 2.5, true, false, nil, []int{1}}
+
+	// equal lists the pairs of indexes into values that are equal, in
+	// addition to every value being equal to itself.
+	equal := map[[2]int]bool{
+		{2, 3}: true, {2, 4}: true, {2, 5}: true,
+		{3, 4}: true, {3, 5}: true, {4, 5}: true,
+	}
+
+	for i, a := range values {
+		for j, b := range values {
+			expected := i == j || equal[[2]int{i, j}] || equal[[2]int{j, i}]
+			row := mapRow{"v": a}
+			if matched := EqualsFilter("v", b).Filter(row); matched != expected {
+				t.Errorf("%#v = %#v: expected %v, got %v", a, b, expected, matched)
+			}
+			if matched := NotEqualsFilter("v", b).Filter(row); matched == expected {
+				t.Errorf("%#v != %#v: expected %v, got %v", a, b, !expected, matched)
+			}
+		}
+	}
+
+	cases := []struct {
+		a, b     interface{}
+		expected int
+		ok       bool
+	}{
+		{1, 2, -1, true},
+		{2.5, 1, 1, true},
+		{uint8(3), int64(3), 0, true},
+		{"b", "a", 1, true},
+		{false, true, -1, true},
+		{"1", 1, 0, false},
+		{1, "1", 0, false},
+		{nil, 1, 0, false},
+		{true, 1, 0, false},
+	}
+	for _, c := range cases {
+		result, ok := compareInterfaces(c.a, c.b)
+		if result != c.expected || ok != c.ok {
+			t.Errorf("compare(%#v, %#v): expected %d, %v, got %d, %v", c.a, c.b, c.expected, c.ok, result, ok)
+		}
+		if matched := LessThanFilter("v", c.b).Filter(mapRow{"v": c.a}); matched != (c.ok && c.expected < 0) {
+			t.Errorf("%#v < %#v: got %v", c.a, c.b, matched)
+		}
+	}
+
+	checkIDs(t, testNames, "SELECT * WHERE name != 0", 1, 2, 3, 4)
+	checkIDs(t, testNames, "SELECT * WHERE name = 0")
+	checkIDs(t, testNames, `SELECT * WHERE name != "John"`, 2, 3, 4)
+}
+
+func TestObserver(t *testing.T) {
+	now := time.Unix(0, 0)
+	clock := func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	var stats []ExecStats
+	observer := func(s ExecStats) {
+		stats = append(stats, s)
+	}
+	e := NewExecutor(testNames, WithClock(clock), WithObserver(observer), WithRequireLimit())
+
+	q, err := Parse(`SELECT DISTINCT ON (name) * WHERE name istarts_with "jo" LIMIT 2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Execute(q); err != nil {
+		t.Fatal(err)
+	}
+	q, err = Parse("SELECT * ORDER BY name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Execute(q); err != ErrLimitRequired {
+		t.Fatalf("expected %v, got %v", ErrLimitRequired, err)
+	}
+
+	expected := []ExecStats{
+		{RowsScanned: 2, RowsMatched: 2, RowsReturned: 2, Duration: time.Second},
+		{Duration: time.Second, Err: ErrLimitRequired},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+}
+
+func TestExecuteAnalyze(t *testing.T) {
+	table := testSliceTable{}
+	for i := 0; i < 10; i++ {
+		table = append(table, map[string]interface{}{"id": i, "name": string(rune('a' + i%3))})
+	}
+	now := time.Unix(0, 0)
+	clock := func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	q, err := Parse(`SELECT * WHERE id >= 2, name != "b", id < 8`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharded := testShardedTable{shards: []testSliceTable{table[:3], table[3:7], table[7:]}}
+	for _, table := range []Table{table, sharded} {
+		res, stats, err := NewExecutor(table, WithClock(clock)).ExecuteAnalyze(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 0 and 1 fail id >= 2; 4 and 7 fail name != "b"; 8 and 9
+		// fail id < 8.
+		expected := ExecStats{
+			RowsScanned:      10,
+			RowsMatched:      4,
+			RowsReturned:     4,
+			RowsFiltered:     []int{2, 2, 2},
+			PeakRowsBuffered: 4,
+			Duration:         time.Second,
+		}
+		if !reflect.DeepEqual(*stats, expected) || len(res.Rows()) != 4 {
+			t.Errorf("expected %+v, got %+v", expected, *stats)
+		}
+	}
+
+	// The clock advances a second each time it's read, so each timed
+	// phase takes a second: adding each of the 10 rows to a group,
+	// building the 3 groups' rows, and sorting them.
+	q, err = Parse("SELECT name, count(*) GROUP BY name ORDER BY name DESC LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, stats, err := NewExecutor(table, WithClock(clock)).ExecuteAnalyze(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.GroupDuration != 11*time.Second || stats.SortDuration != time.Second || stats.PeakRowsBuffered != 3 || stats.RowsReturned != 2 {
+		t.Errorf("unexpected stats %+v", *stats)
+	}
+
+	q, err = Parse("SELECT * ORDER BY name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, stats, err = NewExecutor(table, WithRequireLimit()).ExecuteAnalyze(q)
+	if err != ErrLimitRequired || stats == nil || stats.Err != ErrLimitRequired {
+		t.Errorf("expected %v, got %v, %+v", ErrLimitRequired, err, stats)
+	}
+}
+
+func TestDefaultLimit(t *testing.T) {
+	cases := []struct {
+		query    string
+		options  []Option
+		expected int
+	}{
+		{"SELECT *", []Option{WithDefaultLimit(2)}, 2},
+		{"SELECT * LIMIT 3", []Option{WithDefaultLimit(2)}, 3},
+		{"SELECT * LIMIT ALL", []Option{WithDefaultLimit(2)}, 4},
+		{"SELECT * LIMIT 3", []Option{WithDefaultLimit(1), WithMaxLimit(2)}, 2},
+		{"SELECT * LIMIT ALL", []Option{WithDefaultLimit(1), WithMaxLimit(3)}, 3},
+		{"SELECT *", []Option{WithMaxLimit(3)}, 3},
+		{"SELECT * LIMIT 1", []Option{WithMaxLimit(3)}, 1},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(testDataTable{}, c.options...).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if rows := res.Rows(); len(rows) != c.expected {
+			t.Errorf("%s: expected %d rows, got %d", c.query, c.expected, len(rows))
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"SELECT *", []interface{}{1, 2, 3, 4}},
+		{`SELECT * WHERE name istarts_with "jo" LIMIT 2`, []interface{}{1, 2}},
+		{"SELECT DISTINCT ON (a) * WHERE id > 1", []interface{}{2, 4}},
+	}
+
+	table := testSliceTable{
+		{"id": 1, "name": "John", "a": 1},
+		{"id": 2, "name": "Johnson", "a": 2},
+		{"id": 3, "name": "jolene", "a": 2},
+		{"id": 4, "name": "Alison", "a": 1},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		rows, errs := NewExecutor(table).Stream(context.Background(), q)
+		ids := []interface{}{}
+		for row := range rows {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if err := <-errs; err != nil {
+			t.Fatal(c.query, err)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	q, err := Parse("SELECT * ORDER BY id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, errs := NewExecutor(testNames).Stream(context.Background(), q)
+	if _, ok := <-rows; ok {
+		t.Error("expected no rows")
+	}
+	if err := <-errs; !errors.Is(err, ErrUnsupported) {
+		t.Errorf("expected %v, got %v", ErrUnsupported, err)
+	}
+
+	q, err = Parse("SELECT *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rows, errs = NewExecutor(testNames).Stream(ctx, q)
+	<-rows
+	cancel()
+	for range rows {
+	}
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestExecuteOne(t *testing.T) {
+	e := NewExecutor(testNames)
+
+	q, err := Parse(`SELECT * WHERE name starts_with "John"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := e.ExecuteOne(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := row.Get("id"); id != 1 {
+		t.Errorf("expected id 1, got %v", id)
+	}
+
+	q, err = Parse(`SELECT * WHERE name = "nobody"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.ExecuteOne(q); err != ErrNoRows {
+		t.Errorf("expected %v, got %v", ErrNoRows, err)
+	}
+
+	q, err = Parse("SELECT id ORDER BY id DESC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err = e.ExecuteOne(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := row.Get("id"); id != 4 {
+		t.Errorf("expected id 4, got %v", id)
+	}
+
+	q, err = Parse("SELECT count(*) WHERE id > 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err = e.ExecuteOne(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := row.Get("count(*)"); n != 2 {
+		t.Errorf("expected a count of 2, got %v", n)
+	}
+
+	// The limit of 1 isn't subject to the executor's policy.
+	e = NewExecutor(testNames, WithDisallowClauses(ClauseLimit))
+	for _, query := range []string{"SELECT * WHERE id > 1", "SELECT * ORDER BY id"} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := e.ExecuteOne(q); err != nil {
+			t.Errorf("%s: %v", query, err)
+		}
+	}
+	q, err = Parse("SELECT * ORDER BY id LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.ExecuteOne(q); !errors.Is(err, ErrClauseNotAllowed) {
+		t.Errorf("expected %v, got %v", ErrClauseNotAllowed, err)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	clock := func() time.Time {
+		return time.Unix(10000, 0)
+	}
+	e := NewExecutor(testNames,
+		WithClock(clock),
+		WithDefaultLimit(10),
+		WithMaxLimit(100),
+		WithSchema(Schema{"id": TypeInt, "name": TypeString}),
+		WithUnicodeNormalization(norm.NFC),
+	)
+
+	q, err := Parse("SELECT * WHERE id > \"5\", id < now() - 10, name = \"cafe\u0301\" | 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := e.Plan(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "SELECT *\nWHERE\n  id > 5,\n  id < 9990,\n  name = \"caf\u00e9\" | \"1\"\nLIMIT 10"
+	if pretty := plan.Pretty(); pretty != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, pretty)
+	}
+	if q.Filters[0].Value != "5" || q.Limit != 0 {
+		t.Error("planning changed the query")
+	}
+
+	q, err = Parse("SELECT * LIMIT ALL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err = e.Plan(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Limit != 100 || plan.LimitAll {
+		t.Errorf("expected LIMIT 100, got %d, all %v", plan.Limit, plan.LimitAll)
+	}
+
+	q, err = Parse("SELECT * WHERE missing = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Plan(q); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestCount(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected int
+	}{
+		{"SELECT *", 4},
+		{`SELECT * WHERE name istarts_with "jo"`, 3},
+		{`SELECT * WHERE name istarts_with "jo" LIMIT 2`, 2},
+		{`SELECT name, count(id) WHERE id > 1 GROUP BY name ORDER BY name`, 3},
+		{`SELECT * WHERE id > 10`, 0},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		count, err := NewExecutor(testNames).Count(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if count != c.expected {
+			t.Errorf("%s: expected %d, got %d", c.query, c.expected, count)
+		}
+	}
+
+	// Count applies the executor's schema and limits like Execute.
+	table := testSliceTable{{"port": 80}, {"port": 443}, {"port": 80}}
+	optionCases := []struct {
+		query    string
+		options  []Option
+		expected int
+	}{
+		{`SELECT * WHERE port = "80"`, []Option{WithSchema(Schema{"port": TypeInt})}, 2},
+		{`SELECT *`, []Option{WithDefaultLimit(2)}, 2},
+		{`SELECT * LIMIT ALL`, []Option{WithDefaultLimit(2)}, 3},
+		{`SELECT * LIMIT 3`, []Option{WithMaxLimit(1)}, 1},
+	}
+	for _, c := range optionCases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		e := NewExecutor(table, c.options...)
+		count, err := e.Count(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := e.Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if count != c.expected || len(res.Rows()) != c.expected {
+			t.Errorf("%s: expected %d, got a count of %d and %d rows", c.query, c.expected, count, len(res.Rows()))
+		}
+	}
+
+	q, err := Parse("SELECT sum(*)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table).Count(q); err == nil {
+		t.Error("expected an invalid query to be rejected")
+	}
+}
+
+func TestExecutorPolicy(t *testing.T) {
+	cases := []struct {
+		query    string
+		options  []Option
+		expected error
+	}{
+		{"SELECT *", []Option{WithRequireLimit()}, ErrLimitRequired},
+		{"SELECT * LIMIT 2", []Option{WithRequireLimit()}, nil},
+		{"SELECT * ORDER BY id LIMIT 2", []Option{WithDisallowClauses(ClauseOrderBy)}, ErrClauseNotAllowed},
+		{"SELECT * WHERE id > 1", []Option{WithDisallowClauses(ClauseOrderBy, ClauseWhere)}, ErrClauseNotAllowed},
+		{"SELECT * WHERE id > 1", []Option{WithDisallowClauses(ClauseGroupBy)}, nil},
+		{"SELECT * LIMIT ALL", []Option{WithRequireLimit()}, ErrLimitRequired},
+		{"SELECT * LIMIT ALL", []Option{WithDisallowClauses(ClauseLimit)}, ErrClauseNotAllowed},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = NewExecutor(testDataTable{}, c.options...).Execute(q)
+		if !errors.Is(err, c.expected) {
+			t.Errorf("%s: expected error %v, got %v", c.query, c.expected, err)
+		}
+	}
+}
+
+func TestNowFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "created_at": 1000},
+		{"id": 2, "created_at": 5000},
+		{"id": 3, "created_at": 9000},
+	}
+	clock := func() time.Time {
+		return time.Unix(10000, 0)
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"SELECT * WHERE created_at < now()", []interface{}{1, 2, 3}},
+		{"SELECT * WHERE created_at > now() - 3600", []interface{}{3}},
+		{"SELECT * WHERE created_at < NOW( ) -\n 5000", []interface{}{1}},
+		{"SELECT * WHERE created_at > now() + 1", []interface{}{}},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table, WithClock(clock)).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+}
+
+func BenchmarkExecuteFilters(b *testing.B) {
+	const numColumns = 50
+
+	table := testSliceTable{}
+	for i := 0; i < 1000; i++ {
+		row := map[string]interface{}{"id": i}
+		for c := 0; c < numColumns; c++ {
+			row[fmt.Sprintf("c%d", c)] = i + c
+		}
+		table = append(table, row)
+	}
+
+	for _, numFilters := range []int{1, 10, 50} {
+		// Every filter but the last passes, so each row is checked
+		// against all of them and none are copied into the result.
+		filters := []string{}
+		for c := 0; c < numFilters-1; c++ {
+			filters = append(filters, fmt.Sprintf("c%d >= %d", c, c))
+		}
+		filters = append(filters, "id < 0")
+		q, err := Parse("SELECT * WHERE " + strings.Join(filters, ", "))
+		if err != nil {
+			b.Fatal(err)
+		}
+		exec := NewExecutor(table)
+
+		b.Run(fmt.Sprintf("%d", numFilters), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				if _, err := exec.Execute(q); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+type testLimitedTable struct {
+	testSliceTable
+	limits *[]int
+}
+
+func (t testLimitedTable) NewCursorWithLimit(n int) (Cursor, error) {
+	*t.limits = append(*t.limits, n)
+	rows := t.testSliceTable
+	if n < len(rows) {
+		rows = rows[:n]
+	}
+	return rows.NewCursor()
+}
+
+func TestLimitedTable(t *testing.T) {
+	limits := []int{}
+	table := testLimitedTable{testSliceTable: testNames, limits: &limits}
+
+	checkIDs(t, table, "SELECT * LIMIT 2", 1, 2)
+	checkIDs(t, table, "SELECT *", 1, 2, 3, 4)
+	checkIDs(t, table, "SELECT * WHERE id > 1 LIMIT 2", 2, 3)
+	checkIDs(t, table, "SELECT DISTINCT ON (name) * LIMIT 2", 1, 2)
+
+	q, err := Parse("SELECT * LIMIT 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewExecutor(table)
+	if _, err := e.Count(q); err != nil {
+		t.Fatal(err)
+	}
+	_, token, err := e.ExecutePage(q, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.ExecutePage(q, token); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int{2, 3, 4, 7}
+	if !reflect.DeepEqual(limits, expected) {
+		t.Errorf("expected limits %v, got %v", expected, limits)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	table := testSliceTable{}
+	for i := 1; i <= 50; i++ {
+		table = append(table, map[string]interface{}{"id": i})
+	}
+	ids := func(from, to int) []interface{} {
+		ids := []interface{}{}
+		for i := from; i <= to; i++ {
+			ids = append(ids, i)
+		}
+		return ids
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"SELECT * LIMIT 10 OFFSET 20", ids(21, 30)},
+		{"SELECT * OFFSET 45", ids(46, 50)},
+		{"SELECT * WHERE id > 40 ORDER BY id DESC LIMIT 3 OFFSET 2", []interface{}{48, 47, 46}},
+		{"SELECT * LIMIT 5 OFFSET 100", ids(1, 0)},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		count, err := NewExecutor(table).Count(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(q.OrderBy) == 0 && count != len(c.expected) {
+			t.Errorf("%s: expected a count of %d, got %d", c.query, len(c.expected), count)
+		}
+		if len(q.OrderBy) > 0 {
+			continue
+		}
+		rows, errs := NewExecutor(table).Stream(context.Background(), q)
+		streamed := []interface{}{}
+		for row := range rows {
+			id, _ := row.Get("id")
+			streamed = append(streamed, id)
+		}
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(streamed, c.expected) {
+			t.Errorf("%s: expected to stream %v, got %v", c.query, c.expected, streamed)
+		}
+	}
+
+	q, err := Parse("SELECT * OFFSET 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table, WithDisallowClauses(ClauseOffset)).Execute(q); !errors.Is(err, ErrClauseNotAllowed) {
+		t.Errorf("expected %v, got %v", ErrClauseNotAllowed, err)
+	}
+}
+
+func TestOrFilter(t *testing.T) {
+	table := testSliceTable{}
+	for i := 1; i <= 10; i++ {
+		table = append(table, map[string]interface{}{"id": i, "even": i%2 == 0})
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE id = 1 OR id = 3`, []interface{}{1, 3}},
+		{`SELECT * WHERE id < 3 OR id > 8, id != 10`, []interface{}{1, 2, 9}},
+		{`SELECT * WHERE id = 1 OR (id > 4, id < 7)`, []interface{}{1, 5, 6}},
+		{`SELECT * WHERE (id = 1 OR id = 2) OR id = 10`, []interface{}{1, 2, 10}},
+		{`SELECT * WHERE id = 4 OR missing = 1`, []interface{}{4}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	rows := executeRows(t, table, `SELECT count_if(id = 1 OR id = 2) WHERE id < 5`)
+	if n := rows[0]["count_if(id = 1 OR id = 2)"]; n != 2 {
+		t.Errorf("expected a count of 2, got %v", rows)
+	}
+}
+
+func TestInFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "status": "open"},
+		{"id": 2, "status": "closed"},
+		{"id": 3, "status": "pending"},
+		{"id": 4.0, "status": "open"},
+		{"id": 5},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE status IN ("open", "pending")`, []interface{}{1, 3, 4.0}},
+		{`SELECT * WHERE id IN (2, 4, 6)`, []interface{}{2, 4.0}},
+		{`SELECT * WHERE id IN (1.0, "3")`, []interface{}{1}},
+		{`SELECT * WHERE status IN ()`, []interface{}{}},
+		{`SELECT * WHERE any(status IN ("closed"))`, []interface{}{}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+}
+
+func TestBetweenFilter(t *testing.T) {
+	table := testSliceTable{}
+	for i := 1; i <= 10; i++ {
+		table = append(table, map[string]interface{}{"id": i, "score": float64(i) / 2})
+	}
+	table = append(table, map[string]interface{}{"id": 11, "score": "high"})
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE id BETWEEN 3 AND 5`, []interface{}{3, 4, 5}},
+		{`SELECT * WHERE score BETWEEN 1.5 AND 2`, []interface{}{3, 4}},
+		{`SELECT * WHERE score BETWEEN 4 AND 100`, []interface{}{8, 9, 10}},
+		{`SELECT * WHERE id BETWEEN 5 AND 3`, []interface{}{}},
+		{`SELECT * WHERE id BETWEEN 2 AND 3 OR id = 9`, []interface{}{2, 3, 9}},
+		// NOT BETWEEN excludes the bounds' range, including the bounds.
+		{`SELECT * WHERE id NOT BETWEEN 3 AND 9`, []interface{}{1, 2, 10, 11}},
+		{`SELECT * WHERE score NOT BETWEEN 1 AND 4.5`, []interface{}{1, 10}},
+		{`SELECT * WHERE id NOT BETWEEN 5 AND 3`, []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	q := &Query{Filters: []FilterDesc{{Column: "id", Operator: "between", Value: 1}}}
+	if _, err := NewExecutor(table).Execute(q); err == nil {
+		t.Error("expected an error for a between filter without two values")
+	}
+}
+
+func TestIsNullFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "email": "a@example.com"},
+		{"id": 2},
+		{"id": 3, "email": nil},
+		{"id": 4, "email": ""},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE email IS NULL`, []interface{}{2, 3}},
+		{`SELECT * WHERE email IS NOT NULL`, []interface{}{1, 4}},
+		{`SELECT * WHERE email IS NULL OR email = ""`, []interface{}{2, 3, 4}},
+		{`SELECT * WHERE missing IS NOT NULL`, []interface{}{}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	q, err := Parse(`SELECT * WHERE len(email) IS NULL`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table).Execute(q); err == nil {
+		t.Error("expected an error for IS NULL with a function")
+	}
+}
+
+func TestLikeFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "foobar"},
+		{"id": 2, "name": "foo"},
+		{"id": 3, "name": "barfoo"},
+		{"id": 4, "name": "50% off"},
+		{"id": 5, "name": "a_b"},
+		{"id": 6, "name": "axb\nfoo"},
+		{"id": 7, "name": 100},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE name LIKE "foo%"`, []interface{}{1, 2}},
+		{`SELECT * WHERE name LIKE "%bar"`, []interface{}{1}},
+		{`SELECT * WHERE name LIKE "%oo%"`, []interface{}{1, 2, 3, 6}},
+		{`SELECT * WHERE name LIKE "foo"`, []interface{}{2}},
+		{`SELECT * WHERE name like "a_b%"`, []interface{}{5, 6}},
+		{`SELECT * WHERE name LIKE "a\_b"`, []interface{}{5}},
+		{`SELECT * WHERE name LIKE "%\%%"`, []interface{}{4}},
+		{`SELECT * WHERE name LIKE "1%"`, []interface{}{}},
+		{`SELECT * WHERE name LIKE "f.o%"`, []interface{}{}},
+		// Outside LIKE, \% and \_ are just % and _.
+		{`SELECT * WHERE name = "a\_b"`, []interface{}{5}},
+		{`SELECT * WHERE name = "50\% off"`, []interface{}{4}},
+		{`SELECT * WHERE name starts_with "50\%"`, []interface{}{4}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+}
+
+func TestILikeFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "Foo"},
+		{"id": 2, "name": "FOOBAR"},
+		{"id": 3, "name": "foo"},
+		{"id": 4, "name": "ΣΑΣ"},
+		{"id": 5, "name": 0},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE name ILIKE "foo"`, []interface{}{1, 3}},
+		{`SELECT * WHERE name ilike "FOO%"`, []interface{}{1, 2, 3}},
+		{`SELECT * WHERE name ILIKE "σας"`, []interface{}{4}},
+		{`SELECT * WHERE name ILIKE "0"`, []interface{}{}},
+		// = stays case-sensitive.
+		{`SELECT * WHERE name = "foo"`, []interface{}{3}},
+		{`SELECT * WHERE name LIKE "foo%"`, []interface{}{3}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+}
+
+func TestMatchesFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "path": "/api/users"},
+		{"id": 2, "path": "/static/api.js"},
+		{"id": 3, "path": "/API/v2"},
+		{"id": 4, "path": 404},
+		{"id": 5},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE path matches "api"`, []interface{}{1, 2}},
+		{`SELECT * WHERE path matches "^/api"`, []interface{}{1}},
+		{`SELECT * WHERE path matches "(?i)^/api/"`, []interface{}{1, 3}},
+		{`SELECT * WHERE path matches "[.]js$"`, []interface{}{2}},
+		{`SELECT * WHERE path matches "4"`, []interface{}{}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	q, err := Parse(`SELECT * WHERE path matches "(unclosed"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewExecutor(table).Execute(q)
+	if err == nil || !strings.Contains(err.Error(), "invalid pattern") {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+}
+
+func TestProjection(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "a": "x", "b": 10},
+		{"id": 2, "a": "y"},
+		{"id": 3, "b": 30, "c": true},
+	}
+	fields := func(row Row) []string {
+		fields := row.Fields()
+		sort.Strings(fields)
+		return fields
+	}
+
+	cases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{`SELECT a`, [][]string{{"a"}, {"a"}, {}}},
+		{`SELECT b, id`, [][]string{{"b", "id"}, {"id"}, {"b", "id"}}},
+		{`SELECT *, missing`, [][]string{{"a", "b", "id"}, {"a", "id"}, {"b", "c", "id"}}},
+		{`SELECT a WHERE b > 5 ORDER BY b DESC`, [][]string{{}, {"a"}}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		got := [][]string{}
+		for _, row := range res.Rows() {
+			got = append(got, fields(row))
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected fields %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	q, err := Parse(`SELECT b WHERE id = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := NewExecutor(table).ExecuteOne(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := row.Get("b"); !ok || v != 10 {
+		t.Errorf("expected b = 10, got %v, %v", v, ok)
+	}
+	if _, ok := row.Get("id"); ok {
+		t.Error("expected id not to be selected")
+	}
+}
+
+func TestFieldOrder(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "a": "x", "b": 10, "c": true},
+		{"id": 2, "b": 20, "z": 1},
+	}
+	cases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{`SELECT c, id, a`, [][]string{{"c", "id", "a"}, {"id"}}},
+		{`SELECT *`, [][]string{{"a", "b", "c", "id"}, {"b", "id", "z"}}},
+		{`SELECT b, *`, [][]string{{"b", "a", "c", "id"}, {"b", "id", "z"}}},
+		{`SELECT id AS x, b ORDER BY b DESC`, [][]string{{"x", "b"}, {"x", "b"}}},
+		{`SELECT sum(b), count(id) AS n, c GROUP BY c`, [][]string{{"sum(b)", "n"}, {"sum(b)", "n", "c"}}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		got := [][]string{}
+		for _, row := range res.Rows() {
+			fields := row.Fields()
+			for i := 0; i < 10; i++ {
+				if again := row.Fields(); !reflect.DeepEqual(again, fields) {
+					t.Fatalf("%s: fields changed from %v to %v", c.query, fields, again)
+				}
+			}
+			got = append(got, fields)
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected fields %v, got %v", c.query, c.expected, got)
+		}
+	}
+}
+
+func TestResultJSON(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "a", "tags": []interface{}{"x"}},
+		{"id": 2, "name": nil},
+	}
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{`SELECT name, id`, `[{"name":"a","id":1},{"name":null,"id":2}]`},
+		{`SELECT *`, `[{"id":1,"name":"a","tags":["x"]},{"id":2,"name":null}]`},
+		{`SELECT count(*) AS n, max(id)`, `[{"n":2,"max(id)":2}]`},
+		{`SELECT * WHERE id > 5`, `[]`},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(table).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		b, err := json.Marshal(res)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if string(b) != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.query, c.expected, b)
+		}
+
+		var rows []map[string]interface{}
+		if err := json.Unmarshal(b, &rows); err != nil {
+			t.Fatal(c.query, err)
+		}
+		if len(rows) != len(res.Rows()) {
+			t.Errorf("%s: expected %d rows, got %v", c.query, len(res.Rows()), rows)
+		}
+	}
+
+	if b, err := json.Marshal(&Result{}); err != nil || string(b) != "[]" {
+		t.Errorf("expected an empty result to be [], got %s, %v", b, err)
+	}
+}
+
+func TestExecuteFunc(t *testing.T) {
+	exec := NewExecutor(testDataTable{})
+
+	q, err := Parse("SELECT * WHERE id > 2 LIMIT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	err = exec.ExecuteFunc(q, func(row Row) error {
+		calls++
+		if id, _ := row.Get("id"); id != 3 {
+			t.Errorf("expected id 3, got %v", id)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+
+	q, err = Parse("SELECT id WHERE id > 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := errors.New("stop")
+	calls = 0
+	err = exec.ExecuteFunc(q, func(row Row) error {
+		calls++
+		if len(row.Fields()) != 1 {
+			t.Errorf("expected only id, got %v", row.Fields())
+		}
+		if calls == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || calls != 2 {
+		t.Errorf("expected to stop after 2 calls, got %d calls, %v", calls, err)
+	}
+
+	unsupported := map[string]string{
+		"SELECT * ORDER BY id": "order by",
+		"SELECT a GROUP BY a":  "group by",
+		"SELECT count(id)":     "aggregates",
+	}
+	for query, feature := range unsupported {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = exec.ExecuteFunc(q, func(Row) error { return nil })
+		if !errors.Is(err, ErrUnsupported) {
+			t.Errorf("%s: expected ErrUnsupported, got %v", query, err)
+		}
+		var unsupportedErr *UnsupportedError
+		if !errors.As(err, &unsupportedErr) || unsupportedErr.Feature != feature {
+			t.Errorf("%s: expected an UnsupportedError for %q, got %v", query, feature, err)
+		}
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "a": "x", "b": 1},
+		{"id": 2, "a": "x", "b": 1},
+		{"id": 3, "a": "x", "b": 2},
+		{"id": 4, "a": "y", "b": 1},
+		{"id": 5, "a": "x"},
+		{"id": 6, "a": "x", "b": nil},
+		{"id": 7, "a": "y", "b": 1},
+	}
+
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		{`SELECT DISTINCT a`, []map[string]interface{}{{"a": "x"}, {"a": "y"}}},
+		{`SELECT DISTINCT a, b`, []map[string]interface{}{
+			{"a": "x", "b": 1}, {"a": "x", "b": 2}, {"a": "y", "b": 1}, {"a": "x"}, {"a": "x", "b": nil},
+		}},
+		{`SELECT DISTINCT a WHERE b = 1 LIMIT 1 OFFSET 1`, []map[string]interface{}{{"a": "y"}}},
+		{`SELECT DISTINCT a ORDER BY id DESC`, []map[string]interface{}{{"a": "y"}, {"a": "x"}}},
+		{`SELECT DISTINCT * WHERE id < 3`, []map[string]interface{}{
+			{"id": 1, "a": "x", "b": 1}, {"id": 2, "a": "x", "b": 1},
+		}},
+	}
+	for _, c := range cases {
+		if got := executeRows(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	q, err := Parse(`SELECT DISTINCT a`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	if err := NewExecutor(table).ExecuteFunc(q, func(Row) error { calls++; return nil }); err != nil || calls != 2 {
+		t.Errorf("expected 2 rows from ExecuteFunc, got %d, %v", calls, err)
+	}
+}
+
+func TestBoolFilter(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "active": true},
+		{"id": 2, "active": false},
+		{"id": 3, "active": "true"},
+		{"id": 4, "active": 1},
+		{"id": 5},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE active = true`, []interface{}{1}},
+		{`SELECT * WHERE active = false`, []interface{}{2}},
+		{`SELECT * WHERE active != true`, []interface{}{2, 3, 4}},
+		{`SELECT * WHERE active IN (true, false)`, []interface{}{1, 2}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+}
+
+func TestColumnComparison(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "spent": 10, "budget": 20},
+		{"id": 2, "spent": 30, "budget": 20},
+		{"id": 3, "spent": 20.0, "budget": 20},
+		{"id": 4, "spent": "30", "budget": 20},
+		{"id": 5, "spent": 5},
+		{"id": 6, "spent": "abc", "budget": "abd"},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE spent > budget`, []interface{}{2}},
+		{`SELECT * WHERE spent <= budget`, []interface{}{1, 3, 6}},
+		{`SELECT * WHERE spent = budget`, []interface{}{3}},
+		{`SELECT * WHERE spent != budget`, []interface{}{1, 2, 4, 6}},
+		{`SELECT * WHERE budget < spent OR id = 1`, []interface{}{1, 2}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+
+	for _, query := range []string{`SELECT * WHERE spent matches budget`, `SELECT * WHERE any(spent > budget)`} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(table).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestColumnArithmetic(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "total": 20, "qty": 2, "price": 10},
+		{"id": 2, "total": 25, "qty": 2, "price": 10},
+		{"id": 3, "total": 5.0, "qty": 2, "price": 2.5},
+		{"id": 4, "total": 20, "qty": 2},
+		{"id": 5, "total": 20, "qty": nil, "price": 10},
+	}
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE total = qty * price`, []interface{}{1, 3}},
+		{`SELECT * WHERE total > qty * price`, []interface{}{2}},
+		{`SELECT * WHERE total = price + price`, []interface{}{1, 3, 5}},
+		{`SELECT * WHERE price = total - price`, []interface{}{1, 3, 5}},
+		{`SELECT * WHERE price = total / qty`, []interface{}{1, 3}},
+		{`SELECT * WHERE total != qty * price OR id = 4`, []interface{}{2, 4}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, got)
+		}
+	}
+	if got := executeIDs(t, table, `SELECT * WHERE total = qty * price`, WithKeepMissingColumns()); !reflect.DeepEqual(got, []interface{}{1, 3, 4}) {
+		t.Errorf("expected rows missing a column to be kept, got %v", got)
+	}
+
+	errorCases := []struct {
+		table testSliceTable
+		query string
+		err   string
+	}{
+		{testSliceTable{{"total": 1, "qty": "abc", "price": 2}}, `SELECT * WHERE total = qty * price`, `query: qty * price: "abc" isn't a number`},
+		{testSliceTable{{"total": 1, "qty": 1, "price": 0}}, `SELECT * WHERE total = qty / price`, `query: qty / price: division by zero`},
+		{testSliceTable{{"total": 1, "qty": 1, "price": "x"}}, `SELECT count_if(total = qty + price)`, `query: qty + price: "x" isn't a number`},
+	}
+	for _, c := range errorCases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if _, err := NewExecutor(c.table).Execute(q); err == nil || err.Error() != c.err {
+			t.Errorf("%s: expected error %q, got %v", c.query, c.err, err)
+		}
+	}
+}
+
+func TestMissingColumns(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "a": 1, "b": 2},
+		{"id": 2, "a": 5},
+		{"id": 3, "b": 5},
+		{"id": 4, "tags": []interface{}{"x"}},
+		{"id": 5, "a": nil},
+	}
+
+	cases := []struct {
+		query   string
+		dropped []interface{}
+		kept    []interface{}
+	}{
+		{`SELECT * WHERE a = 1`, []interface{}{1}, []interface{}{1, 3, 4}},
+		{`SELECT * WHERE a != 1`, []interface{}{2, 5}, []interface{}{2, 3, 4, 5}},
+		{`SELECT * WHERE a < b`, []interface{}{1}, []interface{}{1, 2, 3, 4, 5}},
+		{`SELECT * WHERE any(tags = "y")`, []interface{}{}, []interface{}{1, 2, 3, 5}},
+		{`SELECT * WHERE a = 1 OR b = 5`, []interface{}{1, 3}, []interface{}{1, 2, 3, 4, 5}},
+		{`SELECT * WHERE a IS NULL`, []interface{}{3, 4, 5}, []interface{}{3, 4, 5}},
+	}
+	for _, c := range cases {
+		if got := executeIDs(t, table, c.query); !reflect.DeepEqual(got, c.dropped) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.dropped, got)
+		}
+		if got := executeIDs(t, table, c.query, WithKeepMissingColumns()); !reflect.DeepEqual(got, c.kept) {
+			t.Errorf("%s with WithKeepMissingColumns: expected %v, got %v", c.query, c.kept, got)
+		}
+	}
+}
@@ -1,91 +1,815 @@
 package query
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 type expression struct {
 	query          Query
 	currentSection string
+
+	// columnFilters is set while parsing the condition of a
+	// conditional aggregate like count_if(...).
+	columnFilters bool
+
+	// params holds the values for named parameters like :name.
+	params map[string]interface{}
+
+	// casts is the stack of casts like int(...) being parsed.
+	casts []string
+
+	// filterLists is the stack of filter lists being built for the
+	// alternatives of ORs, innermost last.
+	filterLists []*[]FilterDesc
+
+	// alternatives holds the values of the current filter when they're
+	// separated by |, as in status = "open" | "closed".
+	alternatives []interface{}
+
+	// list holds the values of an IN (...) list being parsed.
+	list []interface{}
+
+	// err is the first error encountered while building the query.
+	err error
 }
 
-func (e *expression) AddColumn() {
+// columns returns the column list of the current section, or nil
+// outside of a column section.
+func (e *expression) columns() *[]ColumnDesc {
 	switch e.currentSection {
 	case "columns":
-		e.query.Columns = append(e.query.Columns, ColumnDesc{})
+		return &e.query.Columns
 	case "group by":
-		e.query.GroupBy = append(e.query.GroupBy, ColumnDesc{})
+		return &e.query.GroupBy
 	case "order by":
-		e.query.OrderBy = append(e.query.OrderBy, ColumnDesc{})
+		return &e.query.OrderBy
+	case "distinct on":
+		return &e.query.DistinctOn
+	}
+	return nil
+}
+
+// column returns the last column of the current section, or nil if
+// there isn't one.
+func (e *expression) column() *ColumnDesc {
+	columns := e.columns()
+	if columns == nil || len(*columns) == 0 {
+		return nil
+	}
+	return &(*columns)[len(*columns)-1]
+}
+
+func (e *expression) AddColumn() {
+	if columns := e.columns(); columns != nil {
+		*columns = append(*columns, ColumnDesc{})
 	}
 }
 
 func (e *expression) SetColumnName(name string) {
-	switch e.currentSection {
-	case "columns":
-		e.query.Columns[len(e.query.Columns)-1].Name = name
-	case "group by":
-		e.query.GroupBy[len(e.query.GroupBy)-1].Name = name
-	case "order by":
-		e.query.OrderBy[len(e.query.OrderBy)-1].Name = name
+	if c := e.column(); c != nil {
+		c.Name = name
 	}
 }
 
 func (e *expression) SetColumnAggregate(aggregate string) {
-	switch e.currentSection {
-	case "columns":
-		e.query.Columns[len(e.query.Columns)-1].Aggregate = aggregate
-	case "group by":
-		e.query.GroupBy[len(e.query.GroupBy)-1].Aggregate = aggregate
-	case "order by":
-		e.query.OrderBy[len(e.query.OrderBy)-1].Aggregate = aggregate
+	if c := e.column(); c != nil {
+		c.Aggregate = strings.ToLower(aggregate)
+	}
+}
+
+// SetColumnFunction sets the function applied to the current column,
+// which is either an aggregate or a function like len.
+func (e *expression) SetColumnFunction(function string) {
+	function = strings.ToLower(function)
+	if _, ok := columnFunctions[function]; !ok {
+		e.SetColumnAggregate(function)
+		return
+	}
+	if c := e.column(); c != nil {
+		c.Function = function
+	}
+}
+
+func (e *expression) AddColumnArgument(argument string) {
+	if c := e.column(); c != nil {
+		c.Arguments = append(c.Arguments, argument)
+	}
+}
+
+func (e *expression) AddColumnValueFloat(value string) {
+	f, _ := strconv.ParseFloat(value, 64)
+	e.addColumnValue(f)
+}
+
+func (e *expression) AddColumnValueInteger(value string) {
+	e.addColumnValue(e.parseInteger(value))
+}
+
+func (e *expression) AddColumnValueString(value string) {
+	e.addColumnValue(unquote(value, false))
+}
+
+func (e *expression) AddColumnValueBool(value string) {
+	e.addColumnValue(strings.EqualFold(value, "true"))
+}
+
+func (e *expression) addColumnValue(v interface{}) {
+	if c := e.column(); c != nil {
+		c.Values = append(c.Values, v)
+	}
+}
+
+func (e *expression) SetColumnAlias(alias string) {
+	c := e.column()
+	if c == nil {
+		return
+	}
+	if (e.currentSection != "columns" || c.Name == "*" && c.Aggregate == "") && e.err == nil {
+		e.err = fmt.Errorf("query: AS can only name selected columns")
+	}
+	c.Alias = alias
+}
+
+func (e *expression) BeginColumnFilters() {
+	e.columnFilters = true
+}
+
+func (e *expression) EndColumnFilters() {
+	e.columnFilters = false
+}
+
+// filters returns the filter list the filter actions apply to: the
+// current alternative of an OR, the current column's condition, or the
+// WHERE clause.
+func (e *expression) filters() *[]FilterDesc {
+	if n := len(e.filterLists); n > 0 {
+		return e.filterLists[n-1]
 	}
+	if c := e.column(); e.columnFilters && c != nil {
+		return &c.Filters
+	}
+	return &e.query.Filters
+}
+
+// filter returns the current filter. If no filter has been added it
+// returns a throwaway one so out-of-order actions don't panic.
+func (e *expression) filter() *FilterDesc {
+	filters := *e.filters()
+	if len(filters) == 0 {
+		return &FilterDesc{}
+	}
+	return &filters[len(filters)-1]
 }
 
 func (e *expression) AddFilter() {
-	e.query.Filters = append(e.query.Filters, FilterDesc{})
+	filters := e.filters()
+	*filters = append(*filters, FilterDesc{})
+	e.alternatives = nil
+}
+
+// BeginOr starts a filter that may have alternatives separated by OR.
+// The filters that follow are added to its first alternative.
+func (e *expression) BeginOr() {
+	filters := e.filters()
+	*filters = append(*filters, FilterDesc{Or: [][]FilterDesc{nil}})
+	or := &(*filters)[len(*filters)-1]
+	e.filterLists = append(e.filterLists, &or.Or[0])
+}
+
+// NextOrAlternative starts the next alternative of the current OR.
+func (e *expression) NextOrAlternative() {
+	if len(e.filterLists) == 0 {
+		return
+	}
+	e.filterLists = e.filterLists[:len(e.filterLists)-1]
+	filters := *e.filters()
+	if len(filters) == 0 {
+		return
+	}
+	or := &filters[len(filters)-1]
+	or.Or = append(or.Or, nil)
+	e.filterLists = append(e.filterLists, &or.Or[len(or.Or)-1])
+}
+
+// EndOr ends the current OR. If it only has one alternative, its
+// filters replace it so queries without OR stay flat.
+func (e *expression) EndOr() {
+	if len(e.filterLists) == 0 {
+		return
+	}
+	e.filterLists = e.filterLists[:len(e.filterLists)-1]
+	filters := e.filters()
+	last := len(*filters) - 1
+	if last < 0 || len((*filters)[last].Or) != 1 {
+		return
+	}
+	*filters = append((*filters)[:last], (*filters)[last].Or[0]...)
 }
 
 func (e *expression) SetFilterColumn(column string) {
-	e.query.Filters[len(e.query.Filters)-1].Column = column
+	e.filter().Column = column
+}
+
+func (e *expression) SetFilterFunction(function string) {
+	function = strings.ToLower(function)
+	if _, ok := aggregates[function]; ok && e.err == nil {
+		e.err = fmt.Errorf("query: aggregate %s() cannot be used in WHERE; use HAVING to filter on aggregates", function)
+	}
+	e.filter().Function = function
+}
+
+func (e *expression) AddFilterArgument(argument string) {
+	f := e.filter()
+	f.Arguments = append(f.Arguments, unquote(argument, false))
+}
+
+// SetFilterFunctionStar handles a function applied to * in a filter,
+// which is only meaningful for aggregates like count(*).
+func (e *expression) SetFilterFunctionStar(function string) {
+	e.SetFilterFunction(function)
+	if e.err == nil {
+		e.err = fmt.Errorf("query: %s(*) cannot be used in WHERE", strings.ToLower(function))
+	}
 }
 
 func (e *expression) SetFilterOperator(operator string) {
-	e.query.Filters[len(e.query.Filters)-1].Operator = operator
+	e.filter().Operator = strings.ToLower(operator)
 }
 
 func (e *expression) SetFilterValueFloat(value string) {
 	f, _ := strconv.ParseFloat(value, 64)
-	e.query.Filters[len(e.query.Filters)-1].Value = f
+	e.filter().Value = f
 }
 
 func (e *expression) SetFilterValueInteger(value string) {
-	n, _ := strconv.ParseInt(value, 10, 64)
-	e.query.Filters[len(e.query.Filters)-1].Value = int(n)
+	e.filter().Value = e.parseInteger(value)
+}
+
+// parseInteger parses an integer literal, recording an error if it's
+// out of range.
+func (e *expression) parseInteger(value string) int {
+	// Integers are decimal unless they have a 0x, 0b, or 0o prefix.
+	// A plain leading zero does not mean octal.
+	base := 10
+	if digits := strings.TrimLeft(value, "+-"); len(digits) > 1 && strings.ContainsRune("xXbBoO", rune(digits[1])) {
+		base = 0
+	}
+	n, err := strconv.ParseInt(value, base, 64)
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("query: integer %s is out of range", value)
+	}
+	return int(n)
 }
 
 func (e *expression) SetFilterValueString(value string) {
-	e.query.Filters[len(e.query.Filters)-1].Value = strings.Trim(value, `"`)
+	f := e.filter()
+	op := stringToFilterType(f.Operator)
+	f.Value = unquote(value, op == FilterLike || op == FilterLikeFold)
+}
+
+// unquote returns the string that the string literals in s, which may
+// be adjacent like "a" "b", represent. Escapes are like Go's, except
+// that \x takes any number of hex digits, octal escapes take one to
+// three digits, and \% and \_ are % and _. If s is a LIKE pattern,
+// \% and \_ are kept as they are so they match literally.
+func unquote(s string, pattern bool) string {
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"':
+			quoted = !quoted
+			continue
+		case !quoted:
+			continue
+		case c != '\\' || i+1 == len(s):
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch c := s[i]; c {
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'v':
+			b.WriteByte('\v')
+		case '%', '_':
+			if pattern {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		case 'x':
+			n := countDigits(s[i+1:], hexDigits, len(s))
+			writeCode(&b, s[i+1:i+1+n], 16, true)
+			i += n
+		case 'u', 'U':
+			size := 4
+			if c == 'U' {
+				size = 8
+			}
+			n := countDigits(s[i+1:], hexDigits, size)
+			writeCode(&b, s[i+1:i+1+n], 16, false)
+			i += n
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := countDigits(s[i:], octalDigits, 3)
+			writeCode(&b, s[i:i+n], 8, true)
+			i += n - 1
+		default:
+			// \', \", \?, and \\.
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+const (
+	hexDigits   = "0123456789abcdefABCDEF"
+	octalDigits = "01234567"
+)
+
+// countDigits returns the number of leading characters of s, up to
+// max, that are in digits.
+func countDigits(s, digits string, max int) int {
+	n := 0
+	for n < len(s) && n < max && strings.IndexByte(digits, s[n]) >= 0 {
+		n++
+	}
+	return n
+}
+
+// writeCode writes the character whose code is written in the given
+// base. If bytes is true, codes up to 0xFF are written as single bytes,
+// like \xe9 in Go.
+func writeCode(b *strings.Builder, code string, base int, bytes bool) {
+	n, err := strconv.ParseUint(code, base, 32)
+	switch {
+	case err != nil || n > unicode.MaxRune:
+		b.WriteRune(utf8.RuneError)
+	case bytes && n <= 0xFF:
+		b.WriteByte(byte(n))
+	default:
+		b.WriteRune(rune(n))
+	}
+}
+
+func (e *expression) SetFilterValueParam(name string) {
+	value, ok := e.params[name]
+	if !ok {
+		if e.err == nil {
+			e.err = fmt.Errorf("query: missing parameter %q", name)
+		}
+		return
+	}
+	e.filter().Value = value
+}
+
+func (e *expression) SetFilterValueBool(value string) {
+	e.filter().Value = strings.EqualFold(value, "true")
+}
+
+func (e *expression) SetFilterValueColumn(column string) {
+	if (e.list != nil || e.alternatives != nil || len(e.casts) > 0) && e.err == nil {
+		e.err = fmt.Errorf("query: column %s can only be compared to on its own", column)
+	}
+	f := e.filter()
+	f.Value = nil
+	f.ValueColumn = column
+}
+
+func (e *expression) SetFilterValueOperator(operator string) {
+	e.filter().ValueOperator = operator
+}
+
+func (e *expression) SetFilterValueOperand(column string) {
+	e.filter().ValueOperand = column
+}
+
+func (e *expression) SetFilterValueNull() {
+	e.filter().Value = nil
+}
+
+func (e *expression) SetFilterValueNow() {
+	e.filter().Value = Now{}
+}
+
+func (e *expression) SetFilterValueNowOffset(offset string) {
+	// The offset is a sign followed by optional whitespace and digits.
+	n, _ := strconv.Atoi(strings.TrimSpace(offset[1:]))
+	if offset[0] == '-' {
+		n = -n
+	}
+	e.filter().Value = Now{Offset: n}
+}
+
+func (e *expression) SetFilterQuantifier(quantifier string) {
+	e.filter().Quantifier = strings.ToLower(quantifier)
+}
+
+// SetFilterSample makes the current filter a sample(percent) filter.
+func (e *expression) SetFilterSample(percent string) {
+	f := e.filter()
+	f.Operator = FilterSample.String()
+	p, _ := strconv.ParseFloat(percent, 64)
+	if p > 100 && e.err == nil {
+		e.err = fmt.Errorf("query: sample percentage %s is over 100", percent)
+	}
+	if strings.Contains(percent, ".") {
+		f.Value = p
+	} else {
+		f.Value = int(p)
+	}
+}
+
+func (e *expression) BeginFilterAlternative() {
+	f := e.filter()
+	if f.Operator != "=" && f.Operator != "!=" && e.err == nil {
+		e.err = fmt.Errorf("query: values separated by | are only supported with = and !=")
+	}
+	if f.ValueColumn != "" && e.err == nil {
+		e.err = fmt.Errorf("query: column %s can only be compared to on its own", f.ValueColumn)
+	}
+	if e.alternatives == nil {
+		e.alternatives = []interface{}{f.Value}
+	}
+}
+
+func (e *expression) EndFilterAlternative() {
+	f := e.filter()
+	e.alternatives = append(e.alternatives, f.Value)
+	f.Value = append([]interface{}(nil), e.alternatives...)
+}
+
+func (e *expression) BeginFilterList() {
+	e.list = []interface{}{}
+}
+
+func (e *expression) AddFilterListValue() {
+	e.list = append(e.list, e.filter().Value)
+}
+
+func (e *expression) EndFilterList() {
+	e.filter().Value = e.list
+	e.list = nil
+}
+
+func (e *expression) BeginCast(typ string) {
+	e.casts = append(e.casts, strings.ToLower(typ))
+}
+
+func (e *expression) EndCast() {
+	if len(e.casts) == 0 {
+		return
+	}
+	typ := e.casts[len(e.casts)-1]
+	e.casts = e.casts[:len(e.casts)-1]
+
+	f := e.filter()
+	value, err := castValue(f.Value, typ)
+	if err != nil {
+		if e.err == nil {
+			e.err = err
+		}
+		return
+	}
+	f.Value = value
+}
+
+// castValue converts v to the type named typ: int, float, string,
+// bool, or semver.
+func castValue(v interface{}, typ string) (interface{}, error) {
+	// Numbers of other types are cast like ints or float64s.
+	if n, ok := toInt64(v); ok {
+		v = int(n)
+	} else if f, ok := toFloat64(v); ok {
+		v = f
+	}
+
+	switch typ {
+	case "int":
+		switch v := v.(type) {
+		case int:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) && !math.IsInf(v, 0) {
+				return int(v), nil
+			}
+		case string:
+			if n, err := strconv.Atoi(v); err == nil {
+				return n, nil
+			}
+		case bool:
+			if v {
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case "float":
+		switch v := v.(type) {
+		case int:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		case string:
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return f, nil
+			}
+		}
+	case "string":
+		switch v := v.(type) {
+		case int:
+			return strconv.Itoa(v), nil
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		case string:
+			return v, nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case Version:
+			return v.String(), nil
+		}
+	case "semver":
+		switch v := v.(type) {
+		case string:
+			return ParseVersion(v)
+		case Version:
+			return v, nil
+		}
+	case "bool":
+		switch v := v.(type) {
+		case int:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case float64:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case string:
+			if b, ok := parseBool(v); ok {
+				return b, nil
+			}
+		case bool:
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("query: cannot cast %s to %s", formatValue(v), typ)
+}
+
+// parseBool parses the string forms of booleans, ignoring case and
+// surrounding space: true, t, yes, y, on, and 1, and false, f, no, n,
+// off, and 0.
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "yes", "y", "on", "1":
+		return true, true
+	case "false", "f", "no", "n", "off", "0":
+		return false, true
+	}
+	return false, false
+}
+
+func (e *expression) AddComment(comment string) {
+	e.query.Comments = append(e.query.Comments, strings.TrimSpace(comment))
+}
+
+func (e *expression) SetDistinct() {
+	e.query.Distinct = true
 }
 
 func (e *expression) SetDescending() {
 	e.query.Descending = true
 }
 
+func (e *expression) SetAscending() {
+	e.query.Ascending = true
+}
+
 func (e *expression) SetLimit(num string) {
-	e.query.Limit, _ = strconv.Atoi(num)
+	e.query.Limit = e.atoi("LIMIT", num)
+}
+
+func (e *expression) SetLimitAll() {
+	e.query.LimitAll = true
 }
 
+func (e *expression) SetOffset(num string) {
+	e.query.Offset = e.atoi("OFFSET", num)
+}
+
+// atoi converts the number of a clause, recording an error if it's out
+// of range.
+func (e *expression) atoi(clause, num string) int {
+	n, err := strconv.Atoi(num)
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("query: %s %s is out of range", clause, num)
+	}
+	return n
+}
+
+// Parse parses a query.
 func Parse(query string) (*Query, error) {
-	p := &parser{
-		Buffer: query,
+	return ParseWithParams(query, nil)
+}
+
+// ParseWithParams parses a query containing named parameters like
+// ":uid", substituting the value of each parameter from params. It
+// returns an error if a parameter used in the query is missing from
+// params. Parameters in params that the query doesn't use are ignored.
+func ParseWithParams(query string, params map[string]interface{}) (*Query, error) {
+	return parseRule(query, params, ruleQuery)
+}
+
+// ParseColumns parses a comma-separated list of columns, like the
+// columns of a SELECT without the SELECT keyword.
+func ParseColumns(columns string) ([]ColumnDesc, error) {
+	q, err := parseRule(columns, nil, ruleColumnsOnly)
+	if err != nil {
+		return nil, err
 	}
-	p.Init()
-	err := p.Parse()
+	return q.Columns, nil
+}
+
+// ParseFilters parses a list of filters, like the body of a WHERE
+// clause without the WHERE keyword.
+func ParseFilters(filters string) ([]FilterDesc, error) {
+	q, err := parseRule(filters, nil, ruleFiltersOnly)
 	if err != nil {
 		return nil, err
 	}
+	return q.Filters, nil
+}
+
+// DefaultMaxParenDepth is the deepest nesting of parentheses the parse
+// functions accept, to bound the parser's recursion on untrusted input.
+// A Parser's limit can be changed with its MaxParenDepth.
+const DefaultMaxParenDepth = 100
+
+// checkParenDepth returns an error if parentheses outside of strings
+// in buffer are nested deeper than max. Zero means no limit.
+func checkParenDepth(buffer string, max int) error {
+	if max <= 0 {
+		return nil
+	}
+	depth := 0
+	inString := false
+	for i := 0; i < len(buffer); i++ {
+		switch c := buffer[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case strings.HasPrefix(buffer[i:], "--"):
+			// Skip comments.
+			if end := strings.IndexByte(buffer[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(buffer)
+			}
+		case c == '(':
+			depth++
+			if depth > max {
+				return fmt.Errorf("query: parentheses nested deeper than %d", max)
+			}
+		case c == ')':
+			depth--
+		}
+	}
+	return nil
+}
+
+// parsers holds initialized parsers for the parse functions. A
+// parser's Init allocates a large token tree, so parsers are reused
+// rather than initialized for every query.
+var parsers = sync.Pool{
+	New: func() interface{} {
+		p := &parser{}
+		p.Init()
+		return p
+	},
+}
+
+// parseRule parses buffer starting from the given grammar rule.
+func parseRule(buffer string, params map[string]interface{}, rule pegRule) (*Query, error) {
+	p := parsers.Get().(*parser)
+	defer parsers.Put(p)
+	return p.parseRule(buffer, params, rule, DefaultMaxParenDepth)
+}
+
+// parseRule parses buffer starting from the given grammar rule, reusing
+// the parser's state from earlier calls. Parentheses may be nested at
+// most maxParenDepth deep.
+func (p *parser) parseRule(buffer string, params map[string]interface{}, rule pegRule, maxParenDepth int) (*Query, error) {
+	if err := checkParenDepth(buffer, maxParenDepth); err != nil {
+		return nil, err
+	}
+	p.Buffer = buffer
+	p.Reset()
+	p.expression = expression{params: params}
+	if err := p.Parse(int(rule)); err != nil {
+		if err, ok := err.(*parseError); ok {
+			// Keep the error's buffer from changing with the next parse.
+			return nil, &parseError{p: &parser{buffer: p.buffer}, max: err.max}
+		}
+		return nil, err
+	}
 	p.Execute()
-	return &p.query, nil
+	if p.err != nil {
+		return nil, p.err
+	}
+	q := p.query
+	return &q, nil
+}
+
+// Parser parses queries like Parse, but reuses its memory from one
+// query to the next, which saves allocations when parsing many queries.
+// A Parser isn't safe for concurrent use.
+type Parser struct {
+	// MaxParenDepth is the deepest nesting of parentheses the parser
+	// accepts. It's DefaultMaxParenDepth for a new Parser, and zero
+	// means no limit.
+	MaxParenDepth int
+
+	// Profiling makes the parser record the statistics returned by
+	// Profile. It's off by default since it slows parsing down.
+	Profiling bool
+
+	p       *parser
+	profile map[string]RuleStats
+}
+
+// RuleStats are the statistics of a grammar rule in a Parser's
+// profile. The generated rules call each other directly, so only what
+// the parse tree records is counted: the rule's matches in successful
+// parses, not attempts that failed or backtracked.
+type RuleStats struct {
+	// Matches is the number of times the rule matched.
+	Matches int
+	// Runes is the number of runes of input its matches spanned.
+	Runes int
+	// Duration is the time spent in the rule. It's only measured for
+	// Query, the rule every parse starts with, so it's the time spent
+	// parsing.
+	Duration time.Duration
+}
+
+// NewParser returns a new Parser.
+func NewParser() *Parser {
+	p := &parser{}
+	p.Init()
+	return &Parser{MaxParenDepth: DefaultMaxParenDepth, p: p}
+}
+
+// Parse parses a query.
+func (p *Parser) Parse(query string) (*Query, error) {
+	if !p.Profiling {
+		return p.p.parseRule(query, nil, ruleQuery, p.MaxParenDepth)
+	}
+	start := time.Now()
+	q, err := p.p.parseRule(query, nil, ruleQuery, p.MaxParenDepth)
+	if err != nil {
+		return nil, err
+	}
+	if p.profile == nil {
+		p.profile = map[string]RuleStats{}
+	}
+	for _, token := range p.p.Tokens() {
+		name := rul3s[token.pegRule]
+		stats := p.profile[name]
+		stats.Matches++
+		stats.Runes += int(token.end - token.begin)
+		p.profile[name] = stats
+	}
+	stats := p.profile[rul3s[ruleQuery]]
+	stats.Duration += time.Since(start)
+	p.profile[rul3s[ruleQuery]] = stats
+	return q, nil
+}
+
+// Profile returns the statistics of each grammar rule, by name, over
+// the queries parsed successfully while Profiling was set.
+func (p *Parser) Profile() map[string]RuleStats {
+	profile := map[string]RuleStats{}
+	for name, stats := range p.profile {
+		profile[name] = stats
+	}
+	return profile
 }
@@ -3,8 +3,14 @@ package query
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"net"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 type FilterType int
@@ -18,19 +24,58 @@ const (
 	FilterGreaterThan
 	FilterGreaterThanOrEqual
 	FilterMatches
+	FilterStartsWith
+	FilterEndsWith
+	FilterStartsWithFold
+	FilterEndsWithFold
+	FilterInCIDR
+	FilterIn
+
+	// FilterBetween has a value of two bounds, which are inclusive.
+	FilterBetween
+
+	// FilterNotBetween matches values outside FilterBetween's bounds.
+	FilterNotBetween
+
+	FilterIsNull
+	FilterIsNotNull
+	FilterLike
+
+	// FilterLikeFold is ILIKE, LIKE ignoring case. Without wildcards
+	// it's a case-insensitive =.
+	FilterLikeFold
+
+	// FilterSample is sample(percent) or sample(percent, column), which
+	// isn't written like the other operators.
+	FilterSample
 )
 
+// filterTypeNames are the operators of the filter types.
+var filterTypeNames = map[FilterType]string{
+	FilterEquals:             "=",
+	FilterNotEquals:          "!=",
+	FilterLessThan:           "<",
+	FilterLessThanOrEqual:    "<=",
+	FilterGreaterThan:        ">",
+	FilterGreaterThanOrEqual: ">=",
+	FilterMatches:            "matches",
+	FilterStartsWith:         "starts_with",
+	FilterEndsWith:           "ends_with",
+	FilterStartsWithFold:     "istarts_with",
+	FilterEndsWithFold:       "iends_with",
+	FilterInCIDR:             "in_cidr",
+	FilterIn:                 "in",
+	FilterBetween:            "between",
+	FilterNotBetween:         "not between",
+	FilterIsNull:             "is null",
+	FilterIsNotNull:          "is not null",
+	FilterLike:               "like",
+	FilterLikeFold:           "ilike",
+	FilterSample:             "sample",
+}
+
 func (f FilterType) String() string {
-	rep := map[FilterType]string{
-		FilterEquals:             "=",
-		FilterNotEquals:          "!=",
-		FilterLessThan:           "<",
-		FilterLessThanOrEqual:    "<=",
-		FilterGreaterThan:        ">",
-		FilterGreaterThanOrEqual: ">=",
-		FilterMatches:            "matches",
-	}
-	if str, ok := rep[f]; ok {
+	if str, ok := filterTypeNames[f]; ok {
 		return str
 	}
 	return "?"
@@ -39,13 +84,26 @@ func (f FilterType) String() string {
 func stringToFilterType(s string) FilterType {
 	ft := FilterUnknown
 	rep := map[string]FilterType{
-		"=":       FilterEquals,
-		"!=":      FilterNotEquals,
-		"<":       FilterLessThan,
-		"<=":      FilterLessThanOrEqual,
-		">":       FilterGreaterThan,
-		">=":      FilterGreaterThanOrEqual,
-		"matches": FilterMatches,
+		"=":            FilterEquals,
+		"!=":           FilterNotEquals,
+		"<":            FilterLessThan,
+		"<=":           FilterLessThanOrEqual,
+		">":            FilterGreaterThan,
+		">=":           FilterGreaterThanOrEqual,
+		"matches":      FilterMatches,
+		"starts_with":  FilterStartsWith,
+		"ends_with":    FilterEndsWith,
+		"istarts_with": FilterStartsWithFold,
+		"iends_with":   FilterEndsWithFold,
+		"in_cidr":      FilterInCIDR,
+		"in":           FilterIn,
+		"between":      FilterBetween,
+		"not between":  FilterNotBetween,
+		"is null":      FilterIsNull,
+		"is not null":  FilterIsNotNull,
+		"like":         FilterLike,
+		"ilike":        FilterLikeFold,
+		"sample":       FilterSample,
 	}
 	if f, ok := rep[s]; ok {
 		ft = f
@@ -53,38 +111,257 @@ func stringToFilterType(s string) FilterType {
 	return ft
 }
 
-func buildFilters(queryFilters []FilterDesc) ([]Filter, error) {
+// comparesColumns reports whether filters of type t can compare a
+// column to another column.
+func comparesColumns(t FilterType) bool {
+	switch t {
+	case FilterEquals, FilterNotEquals, FilterLessThan, FilterLessThanOrEqual,
+		FilterGreaterThan, FilterGreaterThanOrEqual:
+		return true
+	}
+	return false
+}
+
+// compareColumnsFunc returns a filter function for filters of type t
+// that compare a column to another column. Like NotEqualsFilter, values
+// of different kinds are not equal.
+func compareColumnsFunc(t FilterType) func(a, b interface{}) bool {
+	return func(a, b interface{}) bool {
+		c, ok := compareInterfaces(a, b)
+		if !ok {
+			return t == FilterNotEquals
+		}
+		switch t {
+		case FilterEquals:
+			return c == 0
+		case FilterNotEquals:
+			return c != 0
+		case FilterLessThan:
+			return c < 0
+		case FilterLessThanOrEqual:
+			return c <= 0
+		case FilterGreaterThan:
+			return c > 0
+		}
+		return c >= 0
+	}
+}
+
+// SupportedOperators returns the filter operators, like "=" and
+// "matches", in the order of their FilterTypes. sample isn't included
+// since it isn't written like an operator.
+func SupportedOperators() []string {
+	types := []FilterType{}
+	for f := range filterTypeNames {
+		if f != FilterSample {
+			types = append(types, f)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	operators := []string{}
+	for _, f := range types {
+		operators = append(operators, filterTypeNames[f])
+	}
+	return operators
+}
+
+func (e *Executor) buildFilters(queryFilters []FilterDesc) ([]Filter, error) {
+	// Compiled regexps are shared between filters with the same
+	// pattern, including filters in different OR alternatives, and so
+	// is the record of errors evaluating rows.
+	return e.buildFiltersWith(queryFilters, map[string]*regexp.Regexp{}, &evalError{})
+}
+
+func (e *Executor) buildFiltersWith(queryFilters []FilterDesc, regexps map[string]*regexp.Regexp, errs *evalError) ([]Filter, error) {
 	filters := []Filter{}
 
 	for _, f := range queryFilters {
+		var filter Filter
+
+		if combined, ok := combinePatterns(f.Or); ok {
+			f = combined
+		} else if f.Or != nil {
+			alternatives := make([][]Filter, len(f.Or))
+			for i, alternative := range f.Or {
+				built, err := e.buildFiltersWith(alternative, regexps, errs)
+				if err != nil {
+					return nil, err
+				}
+				alternatives[i] = built
+			}
+			or := OrFilter(alternatives)
+			or.errs = errs
+			filters = append(filters, or)
+			continue
+		}
+
+		f.Value = e.resolveValue(f.Value)
+
 		filterType := stringToFilterType(f.Operator)
+		if e.collate != nil && collated(filterType) {
+			f.Value = collateValue(f.Value, e.collate)
+		}
+		if f.ValueColumn != "" && (!comparesColumns(filterType) || f.Quantifier != "") {
+			return nil, fmt.Errorf("%s filter can't compare to a column", filterType)
+		}
+		if f.ValueOperator != "" && (f.ValueColumn == "" || f.ValueOperand == "") {
+			return nil, fmt.Errorf("arithmetic needs two columns")
+		}
+		values, multiple := f.Value.([]interface{})
+		if !multiple && filterType == FilterIn {
+			values, multiple = []interface{}{f.Value}, true
+		}
+		between := filterType == FilterBetween || filterType == FilterNotBetween
+		if between && len(values) != 2 {
+			return nil, fmt.Errorf("expected two values for %s filter", filterType)
+		}
+		if multiple && filterType != FilterEquals && filterType != FilterNotEquals && filterType != FilterIn && !between {
+			return nil, fmt.Errorf("multiple values aren't supported for %s filter", filterType)
+		}
+
 		switch filterType {
 		case FilterUnknown:
 			return nil, fmt.Errorf("unknown filter %s", f.Operator)
 
+		case FilterSample:
+			percent, ok := toFloat64(f.Value)
+			if !ok {
+				return nil, fmt.Errorf("expected a number for sample filter")
+			}
+			filter = SampleFilter(f.Column, percent)
+
 		case FilterEquals:
-			filters = append(filters, EqualsFilter(f.Column, f.Value))
+			if multiple {
+				filter = InFilter(f.Column, values)
+			} else {
+				filter = EqualsFilter(f.Column, f.Value)
+			}
 		case FilterNotEquals:
-			filters = append(filters, NotEqualsFilter(f.Column, f.Value))
+			if multiple {
+				filter = NotInFilter(f.Column, values)
+			} else {
+				filter = NotEqualsFilter(f.Column, f.Value)
+			}
+		case FilterIn:
+			filter = InFilter(f.Column, values)
+		case FilterBetween:
+			filter = BetweenFilter(f.Column, values[0], values[1])
+		case FilterNotBetween:
+			filter = NotBetweenFilter(f.Column, values[0], values[1])
+		case FilterIsNull, FilterIsNotNull:
+			if f.Function != "" {
+				return nil, fmt.Errorf("%s filter can't be used with a function", filterType)
+			}
+			filter = IsNullFilter(f.Column, filterType == FilterIsNotNull)
 		case FilterLessThan:
-			filters = append(filters, LessThanFilter(f.Column, f.Value))
+			filter = LessThanFilter(f.Column, f.Value)
 		case FilterLessThanOrEqual:
-			filters = append(filters, LessThanOrEqualFilter(f.Column, f.Value))
+			filter = LessThanOrEqualFilter(f.Column, f.Value)
 		case FilterGreaterThan:
-			filters = append(filters, GreaterThanFilter(f.Column, f.Value))
+			filter = GreaterThanFilter(f.Column, f.Value)
 		case FilterGreaterThanOrEqual:
-			filters = append(filters, GreaterThanOrEqualFilter(f.Column, f.Value))
-		case FilterMatches:
+			filter = GreaterThanOrEqualFilter(f.Column, f.Value)
+		case FilterMatches, FilterLike, FilterLikeFold:
+			str, ok := f.Value.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string value for %s filter", filterType)
+			}
+			str = regexpPattern(filterType, str)
+			r, ok := regexps[str]
+			if !ok {
+				var err error
+				r, err = regexp.Compile(str)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pattern for %s filter: %v", filterType, err)
+				}
+				regexps[str] = r
+			}
+			filter = MatchesFilter(f.Column, r)
+		case FilterInCIDR:
 			str, ok := f.Value.(string)
 			if !ok {
-				return nil, fmt.Errorf("expected string value for matches filter")
+				return nil, fmt.Errorf("expected string value for in_cidr filter")
 			}
-			r, err := regexp.Compile(str)
+			_, network, err := net.ParseCIDR(str)
 			if err != nil {
 				return nil, err
 			}
-			filters = append(filters, MatchesFilter(f.Column, r))
+			filter = InCIDRFilter(f.Column, network)
+		case FilterStartsWith, FilterEndsWith, FilterStartsWithFold, FilterEndsWithFold:
+			str, ok := f.Value.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string value for %s filter", filterType)
+			}
+			switch filterType {
+			case FilterStartsWith:
+				filter = StartsWithFilter(f.Column, str)
+			case FilterEndsWith:
+				filter = EndsWithFilter(f.Column, str)
+			case FilterStartsWithFold:
+				filter = StartsWithFoldFilter(f.Column, str)
+			case FilterEndsWithFold:
+				filter = EndsWithFoldFilter(f.Column, str)
+			}
+		}
+		if f.ValueColumn != "" {
+			filter.valueColumn = f.ValueColumn
+			filter.filterFunc = compareColumnsFunc(filterType)
+			if f.ValueOperator != "" {
+				compute, err := e.arithmetic(f, errs)
+				if err != nil {
+					return nil, err
+				}
+				filter.valueOperand = f.ValueOperand
+				filter.compute = compute
+				filter.errs = errs
+			} else if typ, ok := e.schema[f.ValueColumn]; ok {
+				filter.valueFunction = coerceTo(typ)
+			}
 		}
+
+		if f.Function != "" {
+			build, ok := filterFunctions[f.Function]
+			if !ok {
+				return nil, fmt.Errorf("unknown function %s", f.Function)
+			}
+			fn, err := build(f.Arguments)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", f.Function, err)
+			}
+			filter.function = fn
+		}
+		if typ, ok := e.schema[f.Column]; ok && f.Function == "" {
+			filter.function = coerceTo(typ)
+		}
+		if e.normalize != nil {
+			filter.function = normalizeStrings(filter.function, e.normalize)
+			if filter.valueColumn != "" {
+				filter.valueFunction = normalizeStrings(filter.valueFunction, e.normalize)
+			}
+		}
+		if e.collate != nil && collated(filterType) {
+			filter.function = collateStrings(filter.function, e.collate)
+			if filter.valueColumn != "" {
+				filter.valueFunction = collateStrings(filter.valueFunction, e.collate)
+			}
+		}
+
+		filter.keepMissing = e.keepMissing
+
+		switch f.Quantifier {
+		case "":
+		case "any", "all":
+			if filter.row != nil {
+				return nil, fmt.Errorf("%s filter can't be used with %s", filterType, f.Quantifier)
+			}
+			filter = QuantifiedFilter(filter, f.Quantifier == "all")
+		default:
+			return nil, fmt.Errorf("unknown quantifier %s", f.Quantifier)
+		}
+
+		filters = append(filters, filter)
 	}
 
 	return filters, nil
@@ -94,19 +371,310 @@ type Filter struct {
 	column     string
 	value      interface{}
 	filterFunc func(a, b interface{}) bool
+
+	// function, if set, is applied to the column's value before
+	// filterFunc. It returns false if it can't be applied.
+	function func(v interface{}) (interface{}, bool)
+
+	// row, if set, is used instead of the column and filterFunc for
+	// filters that look at the whole row.
+	row func(r Row) bool
+
+	// valueColumn, if set, is the column of the row that the column
+	// is compared to instead of value. valueFunction, if set, is
+	// applied to its value.
+	valueColumn   string
+	valueFunction func(v interface{}) (interface{}, bool)
+
+	// valueOperand, if set, is the column whose value is combined with
+	// valueColumn's by compute to get the value compared to.
+	valueOperand string
+	compute      func(a, b interface{}) (interface{}, bool)
+
+	// errs, if set, records errors the filter runs into evaluating
+	// rows.
+	errs *evalError
+
+	// keepMissing makes the filter match rows missing its column or
+	// valueColumn.
+	keepMissing bool
 }
 
 func (f Filter) Filter(r Row) bool {
-	if v, ok := r.Get(f.column); !ok {
-		return false
-	} else {
-		return f.filterFunc(v, f.value)
+	if f.row != nil {
+		return f.row(r)
+	}
+	v, ok := r.Get(f.column)
+	if !ok {
+		return f.keepMissing
+	}
+	if f.valueColumn != "" {
+		return f.matchesColumn(r, v)
+	}
+	return f.matches(v)
+}
+
+// matchesColumn reports whether a value of the filter's column matches
+// the value of its valueColumn in the same row.
+func (f Filter) matchesColumn(r Row, v interface{}) bool {
+	other, ok := r.Get(f.valueColumn)
+	if !ok {
+		return f.keepMissing
+	}
+	if f.compute != nil {
+		operand, ok := r.Get(f.valueOperand)
+		if !ok {
+			return f.keepMissing
+		}
+		if other, ok = f.compute(other, operand); !ok {
+			return false
+		}
+	}
+	if f.valueFunction != nil {
+		if other, ok = f.valueFunction(other); !ok {
+			return false
+		}
+	}
+	if f.function != nil {
+		if v, ok = f.function(v); !ok {
+			return false
+		}
+	}
+	return f.filterFunc(v, other)
+}
+
+// passes reports whether r passes every filter, and returns the first
+// error one of them ran into evaluating rows.
+func passes(filters []Filter, r Row) (bool, error) {
+	for _, f := range filters {
+		ok := f.Filter(r)
+		if err := f.err(); err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// err returns the first error the filter ran into evaluating rows.
+func (f Filter) err() error {
+	if f.errs == nil {
+		return nil
+	}
+	return f.errs.get()
+}
+
+// matches reports whether a value of the filter's column matches.
+func (f Filter) matches(v interface{}) bool {
+	if f.function != nil {
+		var ok bool
+		if v, ok = f.function(v); !ok {
+			return false
+		}
+	}
+	return f.filterFunc(v, f.value)
+}
+
+// coerceTo returns a filter function that casts values to typ.
+func coerceTo(typ Type) func(v interface{}) (interface{}, bool) {
+	return func(v interface{}) (interface{}, bool) {
+		v, err := castValue(v, string(typ))
+		return v, err == nil
+	}
+}
+
+// resolveValue returns a filter value with now() resolved to the
+// current time and strings normalized, if the executor normalizes them.
+func (e *Executor) resolveValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case Now:
+		return int(e.clock().Unix()) + v.Offset
+	case string:
+		if e.normalize != nil {
+			return e.normalize(v)
+		}
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i := range v {
+			values[i] = e.resolveValue(v[i])
+		}
+		return values
+	}
+	return v
+}
+
+// normalizeStrings returns a filter function that applies normalize to
+// string values before and after fn, if fn isn't nil.
+func normalizeStrings(fn func(v interface{}) (interface{}, bool), normalize func(string) string) func(v interface{}) (interface{}, bool) {
+	normalizeValue := func(v interface{}) interface{} {
+		if str, ok := v.(string); ok {
+			return normalize(str)
+		}
+		return v
+	}
+	return func(v interface{}) (interface{}, bool) {
+		v = normalizeValue(v)
+		if fn == nil {
+			return v, true
+		}
+		v, ok := fn(v)
+		if !ok {
+			return nil, false
+		}
+		return normalizeValue(v), true
+	}
+}
+
+// filterFunctions are the functions that can be applied to a column
+// on the left side of a filter, e.g. WHERE len(name) > 3. Each one is
+// built once per filter from the arguments that follow the column.
+var filterFunctions = map[string]func(args []interface{}) (func(v interface{}) (interface{}, bool), error){
+	"len":          noArguments(lenFunction),
+	"json_extract": jsonExtractFunction,
+}
+
+func noArguments(fn func(v interface{}) (interface{}, bool)) func(args []interface{}) (func(v interface{}) (interface{}, bool), error) {
+	return func(args []interface{}) (func(v interface{}) (interface{}, bool), error) {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("expected no arguments, got %d", len(args))
+		}
+		return fn, nil
+	}
+}
+
+// lenFunction returns the number of runes in a string or the number of
+// elements in a slice, array, or map. Other values, including nil,
+// have no length.
+func lenFunction(v interface{}) (interface{}, bool) {
+	if str, ok := v.(string); ok {
+		return utf8.RuneCountInString(str), true
+	}
+	if v == nil {
+		return nil, false
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), true
+	}
+	return nil, false
+}
+
+// jsonExtractFunction builds a function that extracts the value at a
+// path like "items[0].price" from nested maps and slices. Strings and
+// byte slices along the way are decoded as JSON. A missing path is
+// null, which never matches.
+func jsonExtractFunction(args []interface{}) (func(v interface{}) (interface{}, bool), error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected a path argument")
+	}
+	path, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string path")
+	}
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return func(v interface{}) (interface{}, bool) {
+		for _, step := range steps {
+			if v = decodeJSON(v); v == nil {
+				return nil, false
+			}
+			rv := reflect.ValueOf(v)
+			switch step := step.(type) {
+			case string:
+				if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+					return nil, false
+				}
+				elem := rv.MapIndex(reflect.ValueOf(step).Convert(rv.Type().Key()))
+				if !elem.IsValid() {
+					return nil, false
+				}
+				v = elem.Interface()
+			case int:
+				if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+					return nil, false
+				}
+				if step >= rv.Len() {
+					return nil, false
+				}
+				v = rv.Index(step).Interface()
+			}
+		}
+		if v == nil {
+			return nil, false
+		}
+		return v, true
+	}, nil
+}
+
+// decodeJSON decodes v if it's a JSON object or array stored as a
+// string or byte slice. Other values are returned as is.
+func decodeJSON(v interface{}) interface{} {
+	var data []byte
+	switch v := v.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return v
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return v
+	}
+	return decoded
+}
+
+// parseJSONPath parses a path like "items[0].price" into map keys
+// (strings) and slice indexes (ints). A leading "$." is optional.
+func parseJSONPath(path string) ([]interface{}, error) {
+	steps := []interface{}{}
+	rest := strings.TrimPrefix(path, "$.")
+	for rest != "" {
+		if rest[0] == '[' {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index in path %q", path)
+			}
+			steps = append(steps, index)
+			rest = rest[end+1:]
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+			steps = append(steps, rest[:end])
+			rest = rest[end:]
+		}
+		if strings.HasPrefix(rest, ".") {
+			rest = rest[1:]
+			if rest == "" {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+		}
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("invalid path %q", path)
 	}
+	return steps, nil
 }
 
 func EqualsFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) == 0
+		c, ok := compare(a)
+		return ok && c == 0
 	}
 	return Filter{
 		column:     column,
@@ -115,9 +683,12 @@ func EqualsFilter(column string, value interface{}) Filter {
 	}
 }
 
+// NotEqualsFilter matches values of a different kind than value, too.
 func NotEqualsFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) != 0
+		c, ok := compare(a)
+		return !ok || c != 0
 	}
 	return Filter{
 		column:     column,
@@ -127,8 +698,10 @@ func NotEqualsFilter(column string, value interface{}) Filter {
 }
 
 func LessThanFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) < 0
+		c, ok := compare(a)
+		return ok && c < 0
 	}
 	return Filter{
 		column:     column,
@@ -138,8 +711,10 @@ func LessThanFilter(column string, value interface{}) Filter {
 }
 
 func LessThanOrEqualFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) <= 0
+		c, ok := compare(a)
+		return ok && c <= 0
 	}
 	return Filter{
 		column:     column,
@@ -149,8 +724,10 @@ func LessThanOrEqualFilter(column string, value interface{}) Filter {
 }
 
 func GreaterThanFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) > 0
+		c, ok := compare(a)
+		return ok && c > 0
 	}
 	return Filter{
 		column:     column,
@@ -160,8 +737,10 @@ func GreaterThanFilter(column string, value interface{}) Filter {
 }
 
 func GreaterThanOrEqualFilter(column string, value interface{}) Filter {
+	compare := comparator(value)
 	filterFunc := func(a, b interface{}) bool {
-		return compareInterfaces(a, b) >= 0
+		c, ok := compare(a)
+		return ok && c >= 0
 	}
 	return Filter{
 		column:     column,
@@ -170,6 +749,261 @@ func GreaterThanOrEqualFilter(column string, value interface{}) Filter {
 	}
 }
 
+// IsNullFilter returns a filter that matches rows where the column is
+// missing or nil, or if not is true, rows where it has a value.
+func IsNullFilter(column string, not bool) Filter {
+	row := func(r Row) bool {
+		v, ok := r.Get(column)
+		return (!ok || v == nil) != not
+	}
+	return Filter{
+		column: column,
+		row:    row,
+	}
+}
+
+// BetweenFilter returns a filter that matches rows where the column's
+// value is at least low and at most high.
+func BetweenFilter(column string, low, high interface{}) Filter {
+	return betweenFilter(column, low, high, false)
+}
+
+// NotBetweenFilter returns a filter that matches rows where the
+// column's value is less than low or greater than high. Values that
+// can't be compared to the bounds don't match either filter.
+func NotBetweenFilter(column string, low, high interface{}) Filter {
+	return betweenFilter(column, low, high, true)
+}
+
+func betweenFilter(column string, low, high interface{}, not bool) Filter {
+	compareLow, compareHigh := comparator(low), comparator(high)
+	filterFunc := func(a, b interface{}) bool {
+		cLow, ok := compareLow(a)
+		if !ok {
+			return false
+		}
+		cHigh, ok := compareHigh(a)
+		if !ok {
+			return false
+		}
+		return (cLow >= 0 && cHigh <= 0) != not
+	}
+	return Filter{
+		column:     column,
+		value:      []interface{}{low, high},
+		filterFunc: filterFunc,
+	}
+}
+
+// InFilter returns a filter that matches rows where the column's value
+// equals any of values.
+func InFilter(column string, values []interface{}) Filter {
+	compares := []func(a interface{}) (int, bool){}
+	for _, v := range values {
+		compares = append(compares, comparator(v))
+	}
+	filterFunc := func(a, b interface{}) bool {
+		for _, compare := range compares {
+			if c, ok := compare(a); ok && c == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	return Filter{
+		column:     column,
+		value:      values,
+		filterFunc: filterFunc,
+	}
+}
+
+// NotInFilter returns a filter that matches rows where the column's
+// value equals none of values.
+func NotInFilter(column string, values []interface{}) Filter {
+	in := InFilter(column, values)
+	filterFunc := func(a, b interface{}) bool {
+		return !in.filterFunc(a, b)
+	}
+	return Filter{
+		column:     column,
+		value:      values,
+		filterFunc: filterFunc,
+	}
+}
+
+// OrFilter returns a filter that matches rows matching every filter of
+// at least one of the alternatives.
+func OrFilter(alternatives [][]Filter) Filter {
+	row := func(r Row) bool {
+	alternatives:
+		for _, filters := range alternatives {
+			for _, f := range filters {
+				if !f.Filter(r) {
+					continue alternatives
+				}
+			}
+			return true
+		}
+		return false
+	}
+	return Filter{row: row}
+}
+
+// SampleFilter returns a filter that matches about percent percent of
+// rows, chosen by a hash of the column's value, or of every field if
+// column is empty. The same rows always match.
+func SampleFilter(column string, percent float64) Filter {
+	threshold := uint64(percent * 100)
+	row := func(r Row) bool {
+		h := fnv.New64a()
+		if column != "" {
+			v, _ := r.Get(column)
+			fmt.Fprintf(h, "%#v", v)
+		} else {
+			fields := r.Fields()
+			sort.Strings(fields)
+			for _, field := range fields {
+				v, _ := r.Get(field)
+				fmt.Fprintf(h, "%q=%#v;", field, v)
+			}
+		}
+		return h.Sum64()%10000 < threshold
+	}
+	return Filter{
+		column: column,
+		value:  percent,
+		row:    row,
+	}
+}
+
+// QuantifiedFilter returns a filter that applies filter to each element
+// of a slice or array column. If all is false, it matches rows where
+// any element matches, and if all is true, rows where every element
+// matches, including rows with an empty slice. Rows where the column
+// is missing or isn't a slice don't match.
+func QuantifiedFilter(filter Filter, all bool) Filter {
+	row := func(r Row) bool {
+		v, ok := r.Get(filter.column)
+		if !ok {
+			return filter.keepMissing
+		}
+		if v == nil {
+			return false
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return false
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if filter.matches(rv.Index(i).Interface()) != all {
+				return !all
+			}
+		}
+		return all
+	}
+	return Filter{
+		column: filter.column,
+		value:  filter.value,
+		row:    row,
+	}
+}
+
+// InCIDRFilter returns a filter that matches rows where the column is an
+// IP address in network. The column may be a string or a net.IP.
+func InCIDRFilter(column string, network *net.IPNet) Filter {
+	filterFunc := func(a, b interface{}) bool {
+		var ip net.IP
+		switch a := a.(type) {
+		case string:
+			ip = net.ParseIP(a)
+		case net.IP:
+			ip = a
+		}
+		return ip != nil && network.Contains(ip)
+	}
+	return Filter{
+		column:     column,
+		value:      network,
+		filterFunc: filterFunc,
+	}
+}
+
+// likePattern returns a regexp matching the strings that the LIKE
+// pattern matches. In the pattern, % matches any sequence of
+// characters, _ matches any one character, and a backslash makes the
+// character after it literal.
+func likePattern(pattern string) string {
+	var b strings.Builder
+	b.WriteString(`(?s)^`)
+	for i := 0; i < len(pattern); {
+		r, size := utf8.DecodeRuneInString(pattern[i:])
+		if r == '\\' && i+size < len(pattern) {
+			i += size
+			_, size = utf8.DecodeRuneInString(pattern[i:])
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+size]))
+			i += size
+			continue
+		}
+		switch r {
+		case '%':
+			b.WriteString(`.*`)
+		case '_':
+			b.WriteString(`.`)
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+size]))
+		}
+		i += size
+	}
+	b.WriteString(`$`)
+	return b.String()
+}
+
+// regexpPattern returns the regexp for the value of a matches, LIKE, or
+// ILIKE filter.
+func regexpPattern(t FilterType, value string) string {
+	switch t {
+	case FilterLike:
+		return likePattern(value)
+	case FilterLikeFold:
+		return `(?i)` + likePattern(value)
+	}
+	return value
+}
+
+// combinePatterns combines OR alternatives that are each a single
+// matches, LIKE, or ILIKE filter on the same column into one matches
+// filter with an alternation of their patterns, so rows are matched
+// against one regexp.
+func combinePatterns(alternatives [][]FilterDesc) (FilterDesc, bool) {
+	if len(alternatives) < 2 {
+		return FilterDesc{}, false
+	}
+	patterns := []string{}
+	for _, alternative := range alternatives {
+		if len(alternative) != 1 {
+			return FilterDesc{}, false
+		}
+		f := alternative[0]
+		t := stringToFilterType(f.Operator)
+		if t != FilterMatches && t != FilterLike && t != FilterLikeFold {
+			return FilterDesc{}, false
+		}
+		value, ok := f.Value.(string)
+		if !ok || f.Column != alternatives[0][0].Column || f.Function != "" || f.Quantifier != "" || f.ValueColumn != "" {
+			return FilterDesc{}, false
+		}
+		patterns = append(patterns, "(?:"+regexpPattern(t, value)+")")
+	}
+	return FilterDesc{
+		Column:   alternatives[0][0].Column,
+		Operator: FilterMatches.String(),
+		Value:    strings.Join(patterns, "|"),
+	}, true
+}
+
+// MatchesFilter returns a filter that matches rows where the column's
+// value is a string that r matches anywhere, unless r is anchored with
+// ^ or $. Rows where the value isn't a string never match.
 func MatchesFilter(column string, r *regexp.Regexp) Filter {
 	filterFunc := func(a, b interface{}) bool {
 		aString, ok := a.(string)
@@ -180,70 +1014,219 @@ func MatchesFilter(column string, r *regexp.Regexp) Filter {
 	}
 	return Filter{
 		column:     column,
+		value:      r,
+		filterFunc: filterFunc,
+	}
+}
+
+// StartsWithFilter returns a filter that matches rows where the
+// column's value, formatted as a string, begins with prefix.
+func StartsWithFilter(column string, prefix string) Filter {
+	filterFunc := func(a, b interface{}) bool {
+		return strings.HasPrefix(fmt.Sprint(a), prefix)
+	}
+	return Filter{
+		column:     column,
+		value:      prefix,
+		filterFunc: filterFunc,
+	}
+}
+
+// EndsWithFilter returns a filter that matches rows where the
+// column's value, formatted as a string, ends with suffix.
+func EndsWithFilter(column string, suffix string) Filter {
+	filterFunc := func(a, b interface{}) bool {
+		return strings.HasSuffix(fmt.Sprint(a), suffix)
+	}
+	return Filter{
+		column:     column,
+		value:      suffix,
+		filterFunc: filterFunc,
+	}
+}
+
+// StartsWithFoldFilter is like StartsWithFilter but ignores case.
+func StartsWithFoldFilter(column string, prefix string) Filter {
+	prefix = strings.ToLower(prefix)
+	filterFunc := func(a, b interface{}) bool {
+		return strings.HasPrefix(strings.ToLower(fmt.Sprint(a)), prefix)
+	}
+	return Filter{
+		column:     column,
+		value:      prefix,
+		filterFunc: filterFunc,
+	}
+}
+
+// EndsWithFoldFilter is like EndsWithFilter but ignores case.
+func EndsWithFoldFilter(column string, suffix string) Filter {
+	suffix = strings.ToLower(suffix)
+	filterFunc := func(a, b interface{}) bool {
+		return strings.HasSuffix(strings.ToLower(fmt.Sprint(a)), suffix)
+	}
+	return Filter{
+		column:     column,
+		value:      suffix,
 		filterFunc: filterFunc,
 	}
 }
 
 func checkEquals(a, b interface{}) bool {
-	return compareInterfaces(a, b) == 0
+	c, ok := compareInterfaces(a, b)
+	return ok && c == 0
 }
 
-func compareInterfaces(a, b interface{}) int {
-	switch a.(type) {
+// comparator returns a function that compares a value to b like
+// compareInterfaces(a, b) does. The common cases are specialized on
+// b's type once rather than switching on both types for every row.
+func comparator(b interface{}) func(a interface{}) (int, bool) {
+	switch b := b.(type) {
 	case int:
-		aInt := a.(int)
-		if bInt, ok := b.(int); ok {
-			if aInt == bInt {
-				return 0
-			} else if aInt < bInt {
-				return -1
-			}
-			return 1
-		} else if bFloat, ok := b.(float64); ok {
-			return compareInterfaces(float64(aInt), bFloat)
-		} else {
-			return 1 - compareInterfaces(b, a)
+		return func(a interface{}) (int, bool) {
+			switch a := a.(type) {
+			case int:
+				return compareInts(int64(a), int64(b)), true
+			case float64:
+				// Decoded JSON numbers are float64.
+				return compareFloats(a, float64(b)), true
+			}
+			return compareInterfaces(a, b)
 		}
 	case float64:
-		aFloat := a.(float64)
-		if bFloat, ok := b.(float64); ok {
-			if aFloat == bFloat {
-				return 0
-			} else if aFloat < bFloat {
-				return -1
-			} else {
-				return 1
+		return func(a interface{}) (int, bool) {
+			switch a := a.(type) {
+			case float64:
+				return compareFloats(a, b), true
+			case int:
+				return compareFloats(float64(a), b), true
 			}
-		} else {
-			return 1 - compareInterfaces(b, a)
+			return compareInterfaces(a, b)
 		}
 	case string:
-		aString := a.(string)
-		if bString, ok := b.(string); ok {
-			if aString == bString {
-				return 0
-			} else if aString < bString {
-				return -1
-			} else {
-				return 1
+		return func(a interface{}) (int, bool) {
+			if aString, ok := a.(string); ok {
+				return strings.Compare(aString, b), true
 			}
-		} else {
-			return 1 - compareInterfaces(b, a)
+			return compareInterfaces(a, b)
 		}
-	case json.Number:
-		aFloat, _ := strconv.ParseFloat(string(a.(json.Number)), 64)
-		if bNumber, ok := b.(json.Number); ok {
-			bFloat, _ := strconv.ParseFloat(string(bNumber), 64)
-			if aFloat == bFloat {
-				return 0
-			} else if aFloat < bFloat {
-				return -1
-			} else {
-				return 1
-			}
-		} else {
-			return 1 - compareInterfaces(b, a)
+	}
+	return func(a interface{}) (int, bool) {
+		return compareInterfaces(a, b)
+	}
+}
+
+// compareInterfaces compares a and b, returning -1, 0, or 1 if a is
+// less than, equal to, or greater than b. Numbers of any type compare
+// by value, strings compare lexically, Versions by precedence, false is
+// less than true, and nil equals nil. Values of different kinds, like a
+// string and a number, can't be compared and compareInterfaces returns
+// false for them.
+func compareInterfaces(a, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case nil:
+		return 0, b == nil
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+		return 0, false
+	case Version:
+		if b, ok := b.(Version); ok {
+			return compareVersions(a, b), true
+		}
+		return 0, false
+	case bool:
+		b, ok := b.(bool)
+		switch {
+		case !ok:
+			return 0, false
+		case a == b:
+			return 0, true
+		case b:
+			return -1, true
+		}
+		return 1, true
+	}
+
+	if aInt, ok := toInt64(a); ok {
+		if bInt, ok := toInt64(b); ok {
+			return compareInts(aInt, bInt), true
 		}
 	}
-	return -1
+	if aFloat, ok := toFloat64(a); ok {
+		if bFloat, ok := toFloat64(b); ok {
+			return compareFloats(aFloat, bFloat), true
+		}
+		return 0, false
+	}
+
+	// Values of other types are only equal to identical values.
+	if reflect.TypeOf(a) == reflect.TypeOf(b) && reflect.DeepEqual(a, b) {
+		return 0, true
+	}
+	return 0, false
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a == b:
+		return 0
+	case a < b:
+		return -1
+	}
+	return 1
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a == b:
+		return 0
+	case a < b:
+		return -1
+	}
+	return 1
+}
+
+// toInt64 returns v as an int64 if it's a signed integer.
+func toInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+// toFloat64 returns v as a float64 if it's a number.
+func toFloat64(v interface{}) (float64, bool) {
+	if i, ok := toInt64(v); ok {
+		return float64(i), true
+	}
+	switch v := v.(type) {
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
 }
@@ -0,0 +1,235 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Pretty returns the query formatted across multiple lines for display,
+// with each clause on its own line and each WHERE filter on its own
+// indented line. Comments come first. The result parses back into an
+// equivalent query.
+func (q *Query) Pretty() string {
+	return strings.Join(q.format(true), "\n")
+}
+
+// SQL returns the query formatted on one line, after a line for each
+// comment. The result parses back into an equivalent query.
+func (q *Query) SQL() string {
+	lines := q.format(false)
+	comments := len(q.Comments)
+	return strings.Join(append(lines[:comments:comments], strings.Join(lines[comments:], " ")), "\n")
+}
+
+// format returns the comments and clauses of the query, one per line.
+// If pretty is true, the WHERE filters get lines of their own.
+func (q *Query) format(pretty bool) []string {
+	lines := []string{}
+
+	for _, comment := range q.Comments {
+		lines = append(lines, "-- "+comment)
+	}
+	if len(q.Columns) > 0 {
+		line := "SELECT "
+		if len(q.DistinctOn) > 0 {
+			line += "DISTINCT ON (" + formatColumns(q.DistinctOn) + ") "
+		} else if q.Distinct {
+			line += "DISTINCT "
+		}
+		lines = append(lines, line+formatColumns(q.Columns))
+	}
+	if len(q.Filters) > 0 {
+		filters := []string{}
+		for _, f := range q.Filters {
+			filters = append(filters, formatFilter(f))
+		}
+		if pretty {
+			lines = append(lines, "WHERE", "  "+strings.Join(filters, ",\n  "))
+		} else {
+			lines = append(lines, "WHERE "+strings.Join(filters, ", "))
+		}
+	}
+	if len(q.GroupBy) > 0 {
+		lines = append(lines, "GROUP BY "+formatColumns(q.GroupBy))
+	}
+	if len(q.OrderBy) > 0 {
+		line := "ORDER BY " + formatColumns(q.OrderBy)
+		if q.Descending {
+			line += " DESC"
+		} else if q.Ascending {
+			line += " ASC"
+		}
+		lines = append(lines, line)
+	}
+	if q.Limit > 0 {
+		lines = append(lines, "LIMIT "+strconv.Itoa(q.Limit))
+	} else if q.LimitAll {
+		lines = append(lines, "LIMIT ALL")
+	}
+	if q.Offset > 0 {
+		lines = append(lines, "OFFSET "+strconv.Itoa(q.Offset))
+	}
+	return lines
+}
+
+func formatColumns(columns []ColumnDesc) string {
+	parts := []string{}
+	for _, c := range columns {
+		if c.Alias != "" {
+			parts = append(parts, formatColumn(c)+" AS "+c.Alias)
+			continue
+		}
+		parts = append(parts, formatColumn(c))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatColumn(c ColumnDesc) string {
+	if c.Function != "" {
+		args := append([]string{c.Name}, c.Arguments...)
+		for _, v := range c.Values {
+			args = append(args, formatValue(v))
+		}
+		return c.Function + "(" + strings.Join(args, ", ") + ")"
+	}
+	if c.Aggregate == "" {
+		return c.Name
+	}
+	if len(c.Filters) > 0 {
+		filters := []string{}
+		for _, f := range c.Filters {
+			filters = append(filters, formatFilter(f))
+		}
+		return c.Aggregate + "(" + strings.Join(filters, ", ") + ")"
+	}
+	return c.Aggregate + "(" + strings.Join(append([]string{c.Name}, c.Arguments...), ", ") + ")"
+}
+
+func formatFilter(f FilterDesc) string {
+	if f.Or != nil {
+		alternatives := []string{}
+		for _, alternative := range f.Or {
+			if len(alternative) == 1 {
+				alternatives = append(alternatives, formatFilter(alternative[0]))
+				continue
+			}
+			filters := []string{}
+			for _, filter := range alternative {
+				filters = append(filters, formatFilter(filter))
+			}
+			alternatives = append(alternatives, "("+strings.Join(filters, ", ")+")")
+		}
+		return strings.Join(alternatives, " OR ")
+	}
+	if f.Operator == FilterSample.String() {
+		if f.Column == "" {
+			return "sample(" + formatValue(f.Value) + ")"
+		}
+		return "sample(" + formatValue(f.Value) + ", " + f.Column + ")"
+	}
+	if f.Quantifier != "" {
+		unquantified := f
+		unquantified.Quantifier = ""
+		return f.Quantifier + "(" + formatFilter(unquantified) + ")"
+	}
+	key := f.Column
+	if f.Function != "" {
+		args := []string{f.Column}
+		for _, arg := range f.Arguments {
+			args = append(args, formatValue(arg))
+		}
+		key = f.Function + "(" + strings.Join(args, ", ") + ")"
+	}
+	if values, ok := f.Value.([]interface{}); ok && len(values) == 2 && (f.Operator == FilterBetween.String() || f.Operator == FilterNotBetween.String()) {
+		return key + " " + strings.ToUpper(f.Operator) + " " + formatValue(values[0]) + " AND " + formatValue(values[1])
+	}
+	if f.ValueColumn != "" && f.ValueOperator != "" {
+		return key + " " + f.Operator + " " + f.ValueColumn + " " + f.ValueOperator + " " + f.ValueOperand
+	}
+	if f.ValueColumn != "" {
+		return key + " " + f.Operator + " " + f.ValueColumn
+	}
+	if f.Operator == FilterIsNull.String() || f.Operator == FilterIsNotNull.String() {
+		return key + " " + strings.ToUpper(f.Operator)
+	}
+	if f.Operator == FilterIn.String() {
+		values, ok := f.Value.([]interface{})
+		if !ok {
+			values = []interface{}{f.Value}
+		}
+		list := []string{}
+		for _, v := range values {
+			list = append(list, formatValue(v))
+		}
+		return key + " IN (" + strings.Join(list, ", ") + ")"
+	}
+	return key + " " + f.Operator + " " + formatValue(f.Value)
+}
+
+// quoteString quotes a string value, escaping the characters that
+// can't be written as they are.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&b, `\%03o`, s[i])
+		case r == '\\' || r == '"':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < ' ' || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+		i += size
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quoteString(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") && !math.IsInf(v, 0) && !math.IsNaN(v) {
+			// Keep the value a float when it's parsed back.
+			s += ".0"
+		}
+		return s
+	case bool:
+		return strconv.FormatBool(v)
+	case Version:
+		return `"` + v.String() + `"`
+	case []interface{}:
+		values := []string{}
+		for _, value := range v {
+			values = append(values, formatValue(value))
+		}
+		return strings.Join(values, " | ")
+	case Now:
+		switch {
+		case v.Offset > 0:
+			return "now() + " + strconv.Itoa(v.Offset)
+		case v.Offset < 0:
+			return "now() - " + strconv.Itoa(-v.Offset)
+		}
+		return "now()"
+	}
+	return fmt.Sprint(v)
+}
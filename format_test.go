@@ -0,0 +1,107 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPretty(t *testing.T) {
+	q, err := Parse(`SELECT a, min(b) WHERE a = 1, len(b) > 2.0, c = "x" GROUP BY a ORDER BY min(b) DESC LIMIT 10`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `SELECT a, min(b)
+WHERE
+  a = 1,
+  len(b) > 2.0,
+  c = "x"
+GROUP BY a
+ORDER BY min(b) DESC
+LIMIT 10`
+	if pretty := q.Pretty(); pretty != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, pretty)
+	}
+}
+
+var formatQueries = []string{
+	"SELECT *",
+	"SELECT * WHERE foo = 1, bar = 2.5e-7 GROUP BY foo ORDER BY bar DESC LIMIT 10",
+	`SELECT a, count_if(b starts_with "x\"y") GROUP BY a`,
+	"SELECT * WHERE flags = 0xFF, ratio < -1e+21",
+	"SELECT * WHERE a > now() - 3600, b < now(), c = now() + 5",
+	"SELECT * WHERE total = qty * price, b <= c - d",
+	"SELECT bucket(latency, 100) AS b, count(*) GROUP BY b ORDER BY b",
+	"SELECT * GROUP BY bucket(size, 0.5)",
+	`SELECT nullif(status, "unknown") AS status, nullif(code, 0), nullif(ok, false)`,
+	`SELECT coalesce(nickname, name, "anon") AS display, coalesce(a, b) ORDER BY coalesce(a, b, 0)`,
+	"SELECT * LIMIT ALL",
+	"SELECT * ORDER BY a LIMIT 10 OFFSET 20",
+	"SELECT * OFFSET 3",
+	"-- @name: daily\nSELECT * -- all\nWHERE a = 1",
+	"SELECT * WHERE any(scores > 90), all(len(tags) = 1 | 2)",
+	"SELECT * WHERE sample(10), sample(2.5, user_id)",
+	`SELECT * WHERE status = "open" | "closed", id != 1 | 2.5 | now()`,
+	`SELECT * WHERE a = bool("true"), b = int("80"), c = float(1)`,
+	"SELECT DISTINCT ON (a, b) * ORDER BY a, b, c DESC",
+	"SELECT a, corr(b, c) GROUP BY a",
+	"SELECT count(*), count_if(a = 1)",
+	`SELECT * WHERE json_extract(payload, "items[0].price") > 10`,
+	"SELECT * WHERE a = 1 OR b = 2 AND c = 3, (d = 4 OR (e = 5, f = 6))",
+	`SELECT * WHERE status IN ("open", "pending"), id in (), score BETWEEN 1 AND 2.5`,
+	`SELECT * WHERE email IS NULL, name IS NOT NULL, x = null`,
+	`SELECT * WHERE name LIKE "50\% off%"`,
+	"SELECT region AS r, count(id) AS total GROUP BY region ORDER BY count(id)",
+	"SELECT a, b",
+	"SELECT DISTINCT a, b WHERE c = 1",
+	"SELECT DISTINCT on_time",
+	"SELECT * ORDER BY len(name), abs(a) DESC",
+}
+
+func TestPrettyParses(t *testing.T) {
+	for _, query := range formatQueries {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		pretty := q.Pretty()
+		reparsed, err := Parse(pretty)
+		if err != nil {
+			t.Errorf("%s: %v", pretty, err)
+			continue
+		}
+		if !reflect.DeepEqual(q, reparsed) {
+			t.Errorf("%s: expected %v, got %v", pretty, q, reparsed)
+		}
+	}
+}
+
+func TestSQLParses(t *testing.T) {
+	for _, query := range append(validQueries, formatQueries...) {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		sql := q.SQL()
+		if len(q.Comments) == 0 && strings.Contains(sql, "\n") {
+			t.Errorf("%s: expected one line", sql)
+		}
+		reparsed, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: %v", sql, err)
+			continue
+		}
+		if !reflect.DeepEqual(q, reparsed) {
+			t.Errorf("%s: expected %v, got %v", sql, q, reparsed)
+		}
+	}
+
+	q := &Query{
+		Columns: []ColumnDesc{{Name: "*"}},
+		Filters: []FilterDesc{{Column: "a", Operator: "=", Value: "say \"hi\"\n"}},
+	}
+	if sql, expected := q.SQL(), `SELECT * WHERE a = "say \"hi\"\n"`; sql != expected {
+		t.Errorf("expected %s, got %s", expected, sql)
+	}
+}
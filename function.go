@@ -0,0 +1,198 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A columnFunction computes a column's value from a row's values of the
+// column's Name and Arguments, which are nil if the row doesn't have
+// them, followed by the column's literal Values.
+type columnFunction struct {
+	apply func(args []interface{}) (interface{}, bool)
+
+	// arguments and values are the numbers of Arguments and Values the
+	// function takes, or -1 for any number.
+	arguments, values int
+
+	// check, if set, checks the function's Values.
+	check func(values []interface{}) error
+}
+
+// columnFunctions are the functions that can be applied to columns, as
+// in SELECT bucket(latency, 100) or ORDER BY len(name). Values a
+// function doesn't apply to are nulls.
+var columnFunctions = map[string]columnFunction{
+	// len takes strings, whose length is in runes, and slices, arrays,
+	// and maps.
+	"len": valueFunction(lenFunction),
+	// lower takes strings.
+	"lower": valueFunction(lowerFunction),
+	// abs takes numbers.
+	"abs": valueFunction(absFunction),
+	// bucket takes numbers and a width.
+	"bucket": {apply: bucketFunction, values: 1, check: checkBucketWidth},
+	// nullif takes any value and the value to replace with null.
+	"nullif": {apply: nullIfFunction, values: 1},
+	// coalesce takes any values, and literal defaults after them.
+	"coalesce": {apply: coalesceFunction, arguments: -1, values: -1},
+}
+
+// valueFunction returns a columnFunction of a single value.
+func valueFunction(fn func(v interface{}) (interface{}, bool)) columnFunction {
+	return columnFunction{apply: func(args []interface{}) (interface{}, bool) {
+		return fn(args[0])
+	}}
+}
+
+func lowerFunction(v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, false
+	}
+	return strings.ToLower(s), true
+}
+
+// absFunction returns the absolute value of a number. Integers stay
+// integers unless they're too small to negate.
+func absFunction(v interface{}) (interface{}, bool) {
+	if n, ok := toInt64(v); ok && n != math.MinInt64 {
+		if n < 0 {
+			n = -n
+		}
+		if _, ok := v.(int); ok {
+			return int(n), true
+		}
+		return n, true
+	}
+	if f, ok := toFloat64(v); ok {
+		return math.Abs(f), true
+	}
+	return nil, false
+}
+
+// bucketFunction floors a number to a multiple of the width, so
+// bucket(latency, 100) is 200 for latencies from 200 up to 300. With an
+// integer width, buckets are int64s, even for floats, so 250 and 250.5
+// are in the same bucket. Otherwise they're float64s.
+func bucketFunction(args []interface{}) (interface{}, bool) {
+	width, widthInt := toInt64(args[1])
+	if n, ok := toInt64(args[0]); ok && widthInt {
+		bucket := n / width * width
+		if n%width < 0 {
+			bucket -= width
+		}
+		return bucket, true
+	}
+	f, ok := toFloat64(args[0])
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	w, _ := toFloat64(args[1])
+	bucket := math.Floor(f/w) * w
+	if !widthInt {
+		return bucket, true
+	}
+	if bucket < math.MinInt64 || bucket >= math.MaxInt64 {
+		return nil, false
+	}
+	return int64(bucket), true
+}
+
+// nullIfFunction returns null if a value equals the given one, as
+// compared by the = filter, so nullif(code, 0) is null for 0 and 0.0,
+// and the value otherwise.
+func nullIfFunction(args []interface{}) (interface{}, bool) {
+	if c, ok := compareInterfaces(args[0], args[1]); ok && c == 0 {
+		return nil, true
+	}
+	return args[0], true
+}
+
+// coalesceFunction returns the first value that isn't null, so
+// coalesce(nickname, name, "anon") is the nickname, or the name if
+// there's no nickname, or "anon" if there's neither. It's null if every
+// value is.
+func coalesceFunction(args []interface{}) (interface{}, bool) {
+	for _, v := range args {
+		if v != nil {
+			return v, true
+		}
+	}
+	return nil, true
+}
+
+func checkBucketWidth(values []interface{}) error {
+	if w, ok := toFloat64(values[0]); !ok || !(w > 0) {
+		return fmt.Errorf("query: bucket() width must be a positive number")
+	}
+	return nil
+}
+
+// columnValue returns a row's value of a column, with the column's
+// function applied.
+func columnValue(c ColumnDesc, row Row) interface{} {
+	args := []interface{}{}
+	for _, name := range append([]string{c.Name}, c.Arguments...) {
+		v, _ := row.Get(name)
+		args = append(args, v)
+	}
+	return applyFunction(c, args...)
+}
+
+// applyFunction returns the column's function of the values of its Name
+// and Arguments, or the first value if it has no function.
+func applyFunction(c ColumnDesc, args ...interface{}) interface{} {
+	if c.Function == "" {
+		return args[0]
+	}
+	fn, ok := columnFunctions[c.Function]
+	if !ok {
+		return nil
+	}
+	v, ok := fn.apply(append(args, c.Values...))
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// validateFunctions checks the functions applied to columns. They can't
+// be used in DISTINCT ON.
+func (q *Query) validateFunctions() error {
+	for _, c := range q.DistinctOn {
+		if c.Function != "" {
+			return fmt.Errorf("query: %s() can't be used in DISTINCT ON", c.Function)
+		}
+	}
+	for _, columns := range [][]ColumnDesc{q.Columns, q.GroupBy, q.OrderBy} {
+		for _, c := range columns {
+			if c.Function == "" {
+				if len(c.Values) > 0 {
+					return fmt.Errorf("query: only functions take values, not %s", formatColumn(c))
+				}
+				continue
+			}
+			fn, ok := columnFunctions[c.Function]
+			if !ok || c.Aggregate != "" {
+				return fmt.Errorf("query: unknown function %s()", c.Function)
+			}
+			if c.Name == "*" {
+				return fmt.Errorf("query: %s() takes columns, not *", c.Function)
+			}
+			if fn.arguments >= 0 && len(c.Arguments) != fn.arguments {
+				return fmt.Errorf("query: %s() takes %d column(s)", c.Function, 1+fn.arguments)
+			}
+			if fn.values >= 0 && len(c.Values) != fn.values {
+				return fmt.Errorf("query: %s() takes %d value(s) after its columns", c.Function, fn.values)
+			}
+			if fn.check != nil {
+				if err := fn.check(c.Values); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
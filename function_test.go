@@ -0,0 +1,193 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testLatencies = testSliceTable{
+	{"id": 1, "latency": 5},
+	{"id": 2, "latency": 120},
+	{"id": 3, "latency": 150},
+	{"id": 4, "latency": 199.5},
+	{"id": 5, "latency": 250},
+	{"id": 6},
+	{"id": 7, "latency": -30},
+}
+
+func TestBucket(t *testing.T) {
+	rows := executeRows(t, testLatencies, "SELECT id, bucket(latency, 100) AS b")
+	buckets := []interface{}{}
+	for _, row := range rows {
+		buckets = append(buckets, row["b"])
+	}
+	expected := []interface{}{int64(0), int64(100), int64(100), int64(100), int64(200), nil, int64(-100)}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Errorf("expected buckets %v, got %v", expected, buckets)
+	}
+
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		{
+			"SELECT bucket(latency, 100) AS b, count(*) GROUP BY b",
+			[]map[string]interface{}{
+				{"b": nil, "count(*)": 1},
+				{"b": int64(-100), "count(*)": 1},
+				{"b": int64(0), "count(*)": 1},
+				{"b": int64(100), "count(*)": 3},
+				{"b": int64(200), "count(*)": 1},
+			},
+		},
+		{
+			"SELECT bucket(latency, 100) AS b, count(*) GROUP BY b ORDER BY count(*) DESC LIMIT 1",
+			[]map[string]interface{}{{"b": int64(100), "count(*)": 3}},
+		},
+		{
+			"SELECT bucket(latency, 100), count(*) WHERE latency > 100 GROUP BY bucket(latency, 100) ORDER BY bucket(latency, 100) DESC",
+			[]map[string]interface{}{
+				{"bucket(latency, 100)": int64(200), "count(*)": 1},
+				{"bucket(latency, 100)": int64(100), "count(*)": 3},
+			},
+		},
+		{
+			"SELECT * WHERE id < 3 GROUP BY bucket(latency, 0.5)",
+			[]map[string]interface{}{{"bucket(latency, 0.5)": 5.0}, {"bucket(latency, 0.5)": 120.0}},
+		},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, testLatencies, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	for _, query := range []string{
+		"SELECT bucket(latency, 0)",
+		"SELECT bucket(latency, -5)",
+		`SELECT bucket(latency, "x")`,
+		"SELECT bucket(latency)",
+		"SELECT bucket(*, 10)",
+		"SELECT bucket(latency, id, 10)",
+		"SELECT bucket(latency, 100) AS b, count(*) GROUP BY latency",
+		"SELECT latency, count(*) GROUP BY bucket(latency, 100)",
+		"SELECT count(latency, 5)",
+	} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(testLatencies).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestNullIf(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "status": "ok", "code": 200},
+		{"id": 2, "status": "unknown", "code": 0.0},
+		{"id": 3, "code": 0},
+		{"id": 4, "status": nil, "code": "0"},
+	}
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		{
+			`SELECT id, nullif(status, "unknown") AS status`,
+			[]map[string]interface{}{
+				{"id": 1, "status": "ok"},
+				{"id": 2, "status": nil},
+				{"id": 3, "status": nil},
+				{"id": 4, "status": nil},
+			},
+		},
+		// Without an alias, the column is named by its expression.
+		// Numbers equal by value, but not strings that look like them.
+		{
+			`SELECT nullif(code, 0)`,
+			[]map[string]interface{}{
+				{"nullif(code, 0)": 200},
+				{"nullif(code, 0)": nil},
+				{"nullif(code, 0)": nil},
+				{"nullif(code, 0)": "0"},
+			},
+		},
+		{
+			`SELECT nullif(status, "unknown") AS s, count(*) GROUP BY s`,
+			[]map[string]interface{}{
+				{"s": nil, "count(*)": 3},
+				{"s": "ok", "count(*)": 1},
+			},
+		},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, table, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	for _, query := range []string{`SELECT nullif(status)`, `SELECT nullif(status, code)`, `SELECT nullif(status, "a", "b")`} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(table).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "nickname": "jj", "name": "John"},
+		{"id": 2, "nickname": nil, "name": "Johnson"},
+		{"id": 3},
+		{"id": 4, "nickname": "", "name": "Alison"},
+	}
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		{
+			`SELECT id, coalesce(nickname, name, "anon") AS display`,
+			[]map[string]interface{}{
+				{"id": 1, "display": "jj"},
+				{"id": 2, "display": "Johnson"},
+				{"id": 3, "display": "anon"},
+				{"id": 4, "display": ""},
+			},
+		},
+		// Without a literal, it's null if every column is.
+		{
+			`SELECT coalesce(nickname, name) WHERE id > 1 ORDER BY coalesce(nickname, name)`,
+			[]map[string]interface{}{
+				{"coalesce(nickname, name)": nil},
+				{"coalesce(nickname, name)": ""},
+				{"coalesce(nickname, name)": "Johnson"},
+			},
+		},
+		{
+			`SELECT coalesce(nickname, "anon") AS n, count(*) GROUP BY n`,
+			[]map[string]interface{}{
+				{"n": "", "count(*)": 1},
+				{"n": "anon", "count(*)": 2},
+				{"n": "jj", "count(*)": 1},
+			},
+		},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, table, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	q, err := Parse(`SELECT coalesce(*, "anon")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table).Execute(q); err == nil {
+		t.Error("expected an error for coalesce(*)")
+	}
+}
@@ -15,20 +15,43 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	ruleQuery
+	ruleColumnsOnly
+	ruleFiltersOnly
 	ruleColumnExpr
+	ruleDistinctOnExpr
 	ruleGroupExpr
 	ruleWhereExpr
 	ruleOrderByExpr
 	ruleLimitExpr
+	ruleOffsetExpr
 	ruleColumns
 	ruleColumn
+	ruleColumnAlias
 	ruleColumnAggregation
+	ruleColumnValue
+	ruleConditionalAggregation
+	ruleFilters
+	ruleDisjunction
+	ruleConjunction
+	ruleFilterTerm
 	ruleLogicExpr
+	ruleFilterComparison
+	ruleFilterInList
+	ruleFilterIsNull
+	ruleFilterBetween
+	ruleSampleExpr
+	ruleQuantifier
 	ruleOPERATOR
 	ruleFilterKey
 	ruleFilterOperator
+	ruleFilterValues
 	ruleFilterValue
+	ruleArithmeticOperator
+	ruleCastValue
+	ruleCastType
+	ruleNowValue
 	ruleDescending
+	ruleAscending
 	ruleString
 	ruleStringChar
 	ruleEscape
@@ -41,22 +64,27 @@ const (
 	ruleUnsigned
 	ruleSign
 	ruleInteger
+	ruleHexNumeral
+	ruleBinaryNumeral
+	ruleOctalNumeral
 	ruleFloat
+	ruleExponent
 	ruleIdentifier
 	ruleIdChar
 	ruleKeyword
 	rule_
+	ruleComment
 	ruleLPAR
 	ruleRPAR
 	ruleCOMMA
 	ruleAction0
 	ruleAction1
 	ruleAction2
-	rulePegText
 	ruleAction3
 	ruleAction4
 	ruleAction5
 	ruleAction6
+	rulePegText
 	ruleAction7
 	ruleAction8
 	ruleAction9
@@ -66,25 +94,101 @@ const (
 	ruleAction13
 	ruleAction14
 	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
+	ruleAction38
+	ruleAction39
+	ruleAction40
+	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"Query",
+	"ColumnsOnly",
+	"FiltersOnly",
 	"ColumnExpr",
+	"DistinctOnExpr",
 	"GroupExpr",
 	"WhereExpr",
 	"OrderByExpr",
 	"LimitExpr",
+	"OffsetExpr",
 	"Columns",
 	"Column",
+	"ColumnAlias",
 	"ColumnAggregation",
+	"ColumnValue",
+	"ConditionalAggregation",
+	"Filters",
+	"Disjunction",
+	"Conjunction",
+	"FilterTerm",
 	"LogicExpr",
+	"FilterComparison",
+	"FilterInList",
+	"FilterIsNull",
+	"FilterBetween",
+	"SampleExpr",
+	"Quantifier",
 	"OPERATOR",
 	"FilterKey",
 	"FilterOperator",
+	"FilterValues",
 	"FilterValue",
+	"ArithmeticOperator",
+	"CastValue",
+	"CastType",
+	"NowValue",
 	"Descending",
+	"Ascending",
 	"String",
 	"StringChar",
 	"Escape",
@@ -97,22 +201,27 @@ var rul3s = [...]string{
 	"Unsigned",
 	"Sign",
 	"Integer",
+	"HexNumeral",
+	"BinaryNumeral",
+	"OctalNumeral",
 	"Float",
+	"Exponent",
 	"Identifier",
 	"IdChar",
 	"Keyword",
 	"_",
+	"Comment",
 	"LPAR",
 	"RPAR",
 	"COMMA",
 	"Action0",
 	"Action1",
 	"Action2",
-	"PegText",
 	"Action3",
 	"Action4",
 	"Action5",
 	"Action6",
+	"PegText",
 	"Action7",
 	"Action8",
 	"Action9",
@@ -122,6 +231,59 @@ var rul3s = [...]string{
 	"Action13",
 	"Action14",
 	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
+	"Action38",
+	"Action39",
+	"Action40",
+	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
 }
 
 type token32 struct {
@@ -238,7 +400,7 @@ type parser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [53]func() bool
+	rules  [134]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -333,35 +495,141 @@ func (p *parser) Execute() {
 		case ruleAction0:
 			p.currentSection = "columns"
 		case ruleAction1:
-			p.currentSection = "group by"
+			p.SetDistinct()
 		case ruleAction2:
-			p.currentSection = "order by"
+			p.currentSection = "columns"
 		case ruleAction3:
-			p.SetLimit(text)
+			p.currentSection = "distinct on"
 		case ruleAction4:
-			p.AddColumn()
+			p.currentSection = "group by"
 		case ruleAction5:
-			p.SetColumnName(text)
+			p.currentSection = "order by"
 		case ruleAction6:
-			p.SetColumnName(text)
+			p.SetLimitAll()
 		case ruleAction7:
-			p.SetColumnAggregate(text)
+			p.SetLimit(text)
 		case ruleAction8:
-			p.SetColumnName(text)
+			p.SetOffset(text)
 		case ruleAction9:
-			p.AddFilter()
+			p.AddColumn()
 		case ruleAction10:
-			p.SetFilterColumn(text)
+			p.SetColumnName(text)
 		case ruleAction11:
-			p.SetFilterOperator(text)
+			p.SetColumnName(text)
 		case ruleAction12:
-			p.SetFilterValueFloat(text)
+			p.SetColumnAlias(text)
 		case ruleAction13:
-			p.SetFilterValueInteger(text)
+			p.SetColumnFunction(text)
 		case ruleAction14:
-			p.SetFilterValueString(text)
+			p.SetColumnName(text)
 		case ruleAction15:
+			p.AddColumnArgument(text)
+		case ruleAction16:
+			p.AddColumnValueFloat(text)
+		case ruleAction17:
+			p.AddColumnValueInteger(text)
+		case ruleAction18:
+			p.AddColumnValueString(text)
+		case ruleAction19:
+			p.AddColumnValueBool(text)
+		case ruleAction20:
+			p.SetColumnAggregate(text)
+		case ruleAction21:
+			p.BeginColumnFilters()
+		case ruleAction22:
+			p.EndColumnFilters()
+		case ruleAction23:
+			p.BeginOr()
+		case ruleAction24:
+			p.NextOrAlternative()
+		case ruleAction25:
+			p.EndOr()
+		case ruleAction26:
+			p.AddFilter()
+		case ruleAction27:
+			p.AddFilter()
+		case ruleAction28:
+			p.SetFilterQuantifier(text)
+		case ruleAction29:
+			p.AddFilter()
+		case ruleAction30:
+			p.SetFilterOperator(text)
+		case ruleAction31:
+			p.BeginFilterList()
+		case ruleAction32:
+			p.AddFilterListValue()
+		case ruleAction33:
+			p.AddFilterListValue()
+		case ruleAction34:
+			p.EndFilterList()
+		case ruleAction35:
+			p.SetFilterOperator("is not null")
+		case ruleAction36:
+			p.SetFilterOperator("is null")
+		case ruleAction37:
+			p.SetFilterOperator("not between")
+		case ruleAction38:
+			p.SetFilterOperator(text)
+		case ruleAction39:
+			p.BeginFilterList()
+		case ruleAction40:
+			p.AddFilterListValue()
+		case ruleAction41:
+			p.AddFilterListValue()
+		case ruleAction42:
+			p.EndFilterList()
+		case ruleAction43:
+			p.SetFilterSample(text)
+		case ruleAction44:
+			p.SetFilterColumn(text)
+		case ruleAction45:
+			p.SetFilterFunction(text)
+		case ruleAction46:
+			p.SetFilterColumn(text)
+		case ruleAction47:
+			p.AddFilterArgument(text)
+		case ruleAction48:
+			p.SetFilterFunctionStar(text)
+		case ruleAction49:
+			p.SetFilterColumn(text)
+		case ruleAction50:
+			p.SetFilterOperator(text)
+		case ruleAction51:
+			p.BeginFilterAlternative()
+		case ruleAction52:
+			p.EndFilterAlternative()
+		case ruleAction53:
+			p.SetFilterValueFloat(text)
+		case ruleAction54:
+			p.SetFilterValueInteger(text)
+		case ruleAction55:
+			p.SetFilterValueString(text)
+		case ruleAction56:
+			p.SetFilterValueParam(text)
+		case ruleAction57:
+			p.SetFilterValueNull()
+		case ruleAction58:
+			p.SetFilterValueBool(text)
+		case ruleAction59:
+			p.SetFilterValueColumn(text)
+		case ruleAction60:
+			p.SetFilterValueOperator(text)
+		case ruleAction61:
+			p.SetFilterValueOperand(text)
+		case ruleAction62:
+			p.BeginCast(text)
+		case ruleAction63:
+			p.EndCast()
+		case ruleAction64:
+			p.SetFilterValueNow()
+		case ruleAction65:
+			p.SetFilterValueNowOffset(text)
+		case ruleAction66:
 			p.SetDescending()
+		case ruleAction67:
+			p.SetAscending()
+		case ruleAction68:
+			p.AddComment(text)
 
 		}
 	}
@@ -436,7 +704,7 @@ func (p *parser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 Query <- <(_ ColumnExpr? _ WhereExpr? _ GroupExpr? _ OrderByExpr? _ LimitExpr? _ !.)> */
+		/* 0 Query <- <(_ ColumnExpr? _ WhereExpr? _ GroupExpr? _ OrderByExpr? _ LimitExpr? _ OffsetExpr? _ !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -511,13 +779,26 @@ func (p *parser) Init() {
 				}
 				{
 					position12, tokenIndex12 := position, tokenIndex
-					if !matchDot() {
+					if !_rules[ruleOffsetExpr]() {
 						goto l12
 					}
-					goto l0
+					goto l13
 				l12:
 					position, tokenIndex = position12, tokenIndex12
 				}
+			l13:
+				if !_rules[rule_]() {
+					goto l0
+				}
+				{
+					position14, tokenIndex14 := position, tokenIndex
+					if !matchDot() {
+						goto l14
+					}
+					goto l0
+				l14:
+					position, tokenIndex = position14, tokenIndex14
+				}
 				add(ruleQuery, position1)
 			}
 			return true
@@ -525,2198 +806,7485 @@ func (p *parser) Init() {
 			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
-		/* 1 ColumnExpr <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') _ Action0 Columns)> */
+		/* 1 ColumnsOnly <- <(_ Action0 Columns _ !.)> */
 		func() bool {
-			position13, tokenIndex13 := position, tokenIndex
+			position15, tokenIndex15 := position, tokenIndex
 			{
-				position14 := position
-				{
-					position15, tokenIndex15 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l16
-					}
-					position++
+				position16 := position
+				if !_rules[rule_]() {
+					goto l15
+				}
+				if !_rules[ruleAction0]() {
+					goto l15
+				}
+				if !_rules[ruleColumns]() {
+					goto l15
+				}
+				if !_rules[rule_]() {
 					goto l15
-				l16:
-					position, tokenIndex = position15, tokenIndex15
-					if buffer[position] != rune('S') {
-						goto l13
-					}
-					position++
 				}
-			l15:
 				{
 					position17, tokenIndex17 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l18
+					if !matchDot() {
+						goto l17
 					}
-					position++
-					goto l17
-				l18:
+					goto l15
+				l17:
 					position, tokenIndex = position17, tokenIndex17
-					if buffer[position] != rune('E') {
-						goto l13
-					}
-					position++
 				}
-			l17:
+				add(ruleColumnsOnly, position16)
+			}
+			return true
+		l15:
+			position, tokenIndex = position15, tokenIndex15
+			return false
+		},
+		/* 2 FiltersOnly <- <(_ Filters _ !.)> */
+		func() bool {
+			position18, tokenIndex18 := position, tokenIndex
+			{
+				position19 := position
+				if !_rules[rule_]() {
+					goto l18
+				}
+				if !_rules[ruleFilters]() {
+					goto l18
+				}
+				if !_rules[rule_]() {
+					goto l18
+				}
 				{
-					position19, tokenIndex19 := position, tokenIndex
-					if buffer[position] != rune('l') {
+					position20, tokenIndex20 := position, tokenIndex
+					if !matchDot() {
 						goto l20
 					}
-					position++
-					goto l19
+					goto l18
 				l20:
-					position, tokenIndex = position19, tokenIndex19
-					if buffer[position] != rune('L') {
-						goto l13
-					}
-					position++
-				}
-			l19:
-				{
-					position21, tokenIndex21 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l22
-					}
-					position++
-					goto l21
-				l22:
-					position, tokenIndex = position21, tokenIndex21
-					if buffer[position] != rune('E') {
-						goto l13
-					}
-					position++
+					position, tokenIndex = position20, tokenIndex20
 				}
-			l21:
+				add(ruleFiltersOnly, position19)
+			}
+			return true
+		l18:
+			position, tokenIndex = position18, tokenIndex18
+			return false
+		},
+		/* 3 ColumnExpr <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') _ (DistinctOnExpr / (('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') !IdChar _ Action1))? Action2 Columns)> */
+		func() bool {
+			position21, tokenIndex21 := position, tokenIndex
+			{
+				position22 := position
 				{
 					position23, tokenIndex23 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('s') {
 						goto l24
 					}
 					position++
 					goto l23
 				l24:
 					position, tokenIndex = position23, tokenIndex23
-					if buffer[position] != rune('C') {
-						goto l13
+					if buffer[position] != rune('S') {
+						goto l21
 					}
 					position++
 				}
 			l23:
 				{
 					position25, tokenIndex25 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l26
 					}
 					position++
 					goto l25
 				l26:
 					position, tokenIndex = position25, tokenIndex25
-					if buffer[position] != rune('T') {
-						goto l13
+					if buffer[position] != rune('E') {
+						goto l21
 					}
 					position++
 				}
 			l25:
-				if !_rules[rule_]() {
-					goto l13
-				}
-				if !_rules[ruleAction0]() {
-					goto l13
-				}
-				if !_rules[ruleColumns]() {
-					goto l13
+				{
+					position27, tokenIndex27 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l28
+					}
+					position++
+					goto l27
+				l28:
+					position, tokenIndex = position27, tokenIndex27
+					if buffer[position] != rune('L') {
+						goto l21
+					}
+					position++
 				}
-				add(ruleColumnExpr, position14)
-			}
-			return true
-		l13:
-			position, tokenIndex = position13, tokenIndex13
-			return false
-		},
-		/* 2 GroupExpr <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') ' ' ('b' / 'B') ('y' / 'Y') _ Action1 Columns)> */
-		func() bool {
-			position27, tokenIndex27 := position, tokenIndex
-			{
-				position28 := position
+			l27:
 				{
 					position29, tokenIndex29 := position, tokenIndex
-					if buffer[position] != rune('g') {
+					if buffer[position] != rune('e') {
 						goto l30
 					}
 					position++
 					goto l29
 				l30:
 					position, tokenIndex = position29, tokenIndex29
-					if buffer[position] != rune('G') {
-						goto l27
+					if buffer[position] != rune('E') {
+						goto l21
 					}
 					position++
 				}
 			l29:
 				{
 					position31, tokenIndex31 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('c') {
 						goto l32
 					}
 					position++
 					goto l31
 				l32:
 					position, tokenIndex = position31, tokenIndex31
-					if buffer[position] != rune('R') {
-						goto l27
+					if buffer[position] != rune('C') {
+						goto l21
 					}
 					position++
 				}
 			l31:
 				{
 					position33, tokenIndex33 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('t') {
 						goto l34
 					}
 					position++
 					goto l33
 				l34:
 					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('O') {
-						goto l27
+					if buffer[position] != rune('T') {
+						goto l21
 					}
 					position++
 				}
 			l33:
+				if !_rules[rule_]() {
+					goto l21
+				}
 				{
 					position35, tokenIndex35 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l36
+					{
+						position37, tokenIndex37 := position, tokenIndex
+						if !_rules[ruleDistinctOnExpr]() {
+							goto l38
+						}
+						goto l37
+					l38:
+						position, tokenIndex = position37, tokenIndex37
+						{
+							position39, tokenIndex39 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l40
+							}
+							position++
+							goto l39
+						l40:
+							position, tokenIndex = position39, tokenIndex39
+							if buffer[position] != rune('D') {
+								goto l35
+							}
+							position++
+						}
+					l39:
+						{
+							position41, tokenIndex41 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l42
+							}
+							position++
+							goto l41
+						l42:
+							position, tokenIndex = position41, tokenIndex41
+							if buffer[position] != rune('I') {
+								goto l35
+							}
+							position++
+						}
+					l41:
+						{
+							position43, tokenIndex43 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l44
+							}
+							position++
+							goto l43
+						l44:
+							position, tokenIndex = position43, tokenIndex43
+							if buffer[position] != rune('S') {
+								goto l35
+							}
+							position++
+						}
+					l43:
+						{
+							position45, tokenIndex45 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l46
+							}
+							position++
+							goto l45
+						l46:
+							position, tokenIndex = position45, tokenIndex45
+							if buffer[position] != rune('T') {
+								goto l35
+							}
+							position++
+						}
+					l45:
+						{
+							position47, tokenIndex47 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l48
+							}
+							position++
+							goto l47
+						l48:
+							position, tokenIndex = position47, tokenIndex47
+							if buffer[position] != rune('I') {
+								goto l35
+							}
+							position++
+						}
+					l47:
+						{
+							position49, tokenIndex49 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l50
+							}
+							position++
+							goto l49
+						l50:
+							position, tokenIndex = position49, tokenIndex49
+							if buffer[position] != rune('N') {
+								goto l35
+							}
+							position++
+						}
+					l49:
+						{
+							position51, tokenIndex51 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l52
+							}
+							position++
+							goto l51
+						l52:
+							position, tokenIndex = position51, tokenIndex51
+							if buffer[position] != rune('C') {
+								goto l35
+							}
+							position++
+						}
+					l51:
+						{
+							position53, tokenIndex53 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l54
+							}
+							position++
+							goto l53
+						l54:
+							position, tokenIndex = position53, tokenIndex53
+							if buffer[position] != rune('T') {
+								goto l35
+							}
+							position++
+						}
+					l53:
+						{
+							position55, tokenIndex55 := position, tokenIndex
+							if !_rules[ruleIdChar]() {
+								goto l55
+							}
+							goto l35
+						l55:
+							position, tokenIndex = position55, tokenIndex55
+						}
+						if !_rules[rule_]() {
+							goto l35
+						}
+						if !_rules[ruleAction1]() {
+							goto l35
+						}
 					}
-					position++
-					goto l35
-				l36:
+				l37:
+					goto l36
+				l35:
 					position, tokenIndex = position35, tokenIndex35
-					if buffer[position] != rune('U') {
-						goto l27
+				}
+			l36:
+				if !_rules[ruleAction2]() {
+					goto l21
+				}
+				if !_rules[ruleColumns]() {
+					goto l21
+				}
+				add(ruleColumnExpr, position22)
+			}
+			return true
+		l21:
+			position, tokenIndex = position21, tokenIndex21
+			return false
+		},
+		/* 4 DistinctOnExpr <- <(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') _ (('o' / 'O') ('n' / 'N')) LPAR Action3 Columns RPAR)> */
+		func() bool {
+			position56, tokenIndex56 := position, tokenIndex
+			{
+				position57 := position
+				{
+					position58, tokenIndex58 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l59
+					}
+					position++
+					goto l58
+				l59:
+					position, tokenIndex = position58, tokenIndex58
+					if buffer[position] != rune('D') {
+						goto l56
 					}
 					position++
 				}
-			l35:
+			l58:
 				{
-					position37, tokenIndex37 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l38
+					position60, tokenIndex60 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l61
 					}
 					position++
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
-					if buffer[position] != rune('P') {
-						goto l27
+					goto l60
+				l61:
+					position, tokenIndex = position60, tokenIndex60
+					if buffer[position] != rune('I') {
+						goto l56
 					}
 					position++
 				}
-			l37:
-				if buffer[position] != rune(' ') {
-					goto l27
+			l60:
+				{
+					position62, tokenIndex62 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l63
+					}
+					position++
+					goto l62
+				l63:
+					position, tokenIndex = position62, tokenIndex62
+					if buffer[position] != rune('S') {
+						goto l56
+					}
+					position++
 				}
-				position++
+			l62:
 				{
-					position39, tokenIndex39 := position, tokenIndex
-					if buffer[position] != rune('b') {
-						goto l40
+					position64, tokenIndex64 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l65
 					}
 					position++
-					goto l39
-				l40:
-					position, tokenIndex = position39, tokenIndex39
-					if buffer[position] != rune('B') {
-						goto l27
+					goto l64
+				l65:
+					position, tokenIndex = position64, tokenIndex64
+					if buffer[position] != rune('T') {
+						goto l56
 					}
 					position++
 				}
-			l39:
+			l64:
 				{
-					position41, tokenIndex41 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l42
+					position66, tokenIndex66 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l67
 					}
 					position++
-					goto l41
-				l42:
-					position, tokenIndex = position41, tokenIndex41
-					if buffer[position] != rune('Y') {
-						goto l27
+					goto l66
+				l67:
+					position, tokenIndex = position66, tokenIndex66
+					if buffer[position] != rune('I') {
+						goto l56
 					}
 					position++
 				}
-			l41:
-				if !_rules[rule_]() {
-					goto l27
+			l66:
+				{
+					position68, tokenIndex68 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l69
+					}
+					position++
+					goto l68
+				l69:
+					position, tokenIndex = position68, tokenIndex68
+					if buffer[position] != rune('N') {
+						goto l56
+					}
+					position++
 				}
-				if !_rules[ruleAction1]() {
-					goto l27
+			l68:
+				{
+					position70, tokenIndex70 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l71
+					}
+					position++
+					goto l70
+				l71:
+					position, tokenIndex = position70, tokenIndex70
+					if buffer[position] != rune('C') {
+						goto l56
+					}
+					position++
+				}
+			l70:
+				{
+					position72, tokenIndex72 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l73
+					}
+					position++
+					goto l72
+				l73:
+					position, tokenIndex = position72, tokenIndex72
+					if buffer[position] != rune('T') {
+						goto l56
+					}
+					position++
+				}
+			l72:
+				if !_rules[rule_]() {
+					goto l56
+				}
+				{
+					position74, tokenIndex74 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l75
+					}
+					position++
+					goto l74
+				l75:
+					position, tokenIndex = position74, tokenIndex74
+					if buffer[position] != rune('O') {
+						goto l56
+					}
+					position++
+				}
+			l74:
+				{
+					position76, tokenIndex76 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l77
+					}
+					position++
+					goto l76
+				l77:
+					position, tokenIndex = position76, tokenIndex76
+					if buffer[position] != rune('N') {
+						goto l56
+					}
+					position++
+				}
+			l76:
+				if !_rules[ruleLPAR]() {
+					goto l56
+				}
+				if !_rules[ruleAction3]() {
+					goto l56
 				}
 				if !_rules[ruleColumns]() {
-					goto l27
+					goto l56
+				}
+				if !_rules[ruleRPAR]() {
+					goto l56
+				}
+				add(ruleDistinctOnExpr, position57)
+			}
+			return true
+		l56:
+			position, tokenIndex = position56, tokenIndex56
+			return false
+		},
+		/* 5 GroupExpr <- <(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') ' ' ('b' / 'B') ('y' / 'Y') _ Action4 Columns)> */
+		func() bool {
+			position78, tokenIndex78 := position, tokenIndex
+			{
+				position79 := position
+				{
+					position80, tokenIndex80 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l81
+					}
+					position++
+					goto l80
+				l81:
+					position, tokenIndex = position80, tokenIndex80
+					if buffer[position] != rune('G') {
+						goto l78
+					}
+					position++
+				}
+			l80:
+				{
+					position82, tokenIndex82 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l83
+					}
+					position++
+					goto l82
+				l83:
+					position, tokenIndex = position82, tokenIndex82
+					if buffer[position] != rune('R') {
+						goto l78
+					}
+					position++
+				}
+			l82:
+				{
+					position84, tokenIndex84 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l85
+					}
+					position++
+					goto l84
+				l85:
+					position, tokenIndex = position84, tokenIndex84
+					if buffer[position] != rune('O') {
+						goto l78
+					}
+					position++
+				}
+			l84:
+				{
+					position86, tokenIndex86 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l87
+					}
+					position++
+					goto l86
+				l87:
+					position, tokenIndex = position86, tokenIndex86
+					if buffer[position] != rune('U') {
+						goto l78
+					}
+					position++
+				}
+			l86:
+				{
+					position88, tokenIndex88 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l89
+					}
+					position++
+					goto l88
+				l89:
+					position, tokenIndex = position88, tokenIndex88
+					if buffer[position] != rune('P') {
+						goto l78
+					}
+					position++
+				}
+			l88:
+				if buffer[position] != rune(' ') {
+					goto l78
+				}
+				position++
+				{
+					position90, tokenIndex90 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l91
+					}
+					position++
+					goto l90
+				l91:
+					position, tokenIndex = position90, tokenIndex90
+					if buffer[position] != rune('B') {
+						goto l78
+					}
+					position++
+				}
+			l90:
+				{
+					position92, tokenIndex92 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l93
+					}
+					position++
+					goto l92
+				l93:
+					position, tokenIndex = position92, tokenIndex92
+					if buffer[position] != rune('Y') {
+						goto l78
+					}
+					position++
+				}
+			l92:
+				if !_rules[rule_]() {
+					goto l78
+				}
+				if !_rules[ruleAction4]() {
+					goto l78
+				}
+				if !_rules[ruleColumns]() {
+					goto l78
 				}
-				add(ruleGroupExpr, position28)
+				add(ruleGroupExpr, position79)
 			}
 			return true
-		l27:
-			position, tokenIndex = position27, tokenIndex27
+		l78:
+			position, tokenIndex = position78, tokenIndex78
 			return false
 		},
-		/* 3 WhereExpr <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') _ LogicExpr (_ COMMA? LogicExpr)*)> */
+		/* 6 WhereExpr <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E') _ Filters)> */
 		func() bool {
-			position43, tokenIndex43 := position, tokenIndex
+			position94, tokenIndex94 := position, tokenIndex
 			{
-				position44 := position
+				position95 := position
 				{
-					position45, tokenIndex45 := position, tokenIndex
+					position96, tokenIndex96 := position, tokenIndex
 					if buffer[position] != rune('w') {
-						goto l46
+						goto l97
 					}
 					position++
-					goto l45
-				l46:
-					position, tokenIndex = position45, tokenIndex45
+					goto l96
+				l97:
+					position, tokenIndex = position96, tokenIndex96
 					if buffer[position] != rune('W') {
-						goto l43
+						goto l94
 					}
 					position++
 				}
-			l45:
+			l96:
 				{
-					position47, tokenIndex47 := position, tokenIndex
+					position98, tokenIndex98 := position, tokenIndex
 					if buffer[position] != rune('h') {
-						goto l48
+						goto l99
 					}
 					position++
-					goto l47
-				l48:
-					position, tokenIndex = position47, tokenIndex47
+					goto l98
+				l99:
+					position, tokenIndex = position98, tokenIndex98
 					if buffer[position] != rune('H') {
-						goto l43
+						goto l94
 					}
 					position++
 				}
-			l47:
+			l98:
 				{
-					position49, tokenIndex49 := position, tokenIndex
+					position100, tokenIndex100 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l50
+						goto l101
 					}
 					position++
-					goto l49
-				l50:
-					position, tokenIndex = position49, tokenIndex49
+					goto l100
+				l101:
+					position, tokenIndex = position100, tokenIndex100
 					if buffer[position] != rune('E') {
-						goto l43
+						goto l94
 					}
 					position++
 				}
-			l49:
+			l100:
 				{
-					position51, tokenIndex51 := position, tokenIndex
+					position102, tokenIndex102 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l52
+						goto l103
 					}
 					position++
-					goto l51
-				l52:
-					position, tokenIndex = position51, tokenIndex51
+					goto l102
+				l103:
+					position, tokenIndex = position102, tokenIndex102
 					if buffer[position] != rune('R') {
-						goto l43
+						goto l94
 					}
 					position++
 				}
-			l51:
+			l102:
 				{
-					position53, tokenIndex53 := position, tokenIndex
+					position104, tokenIndex104 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l54
+						goto l105
 					}
 					position++
-					goto l53
-				l54:
-					position, tokenIndex = position53, tokenIndex53
+					goto l104
+				l105:
+					position, tokenIndex = position104, tokenIndex104
 					if buffer[position] != rune('E') {
-						goto l43
+						goto l94
 					}
 					position++
 				}
-			l53:
+			l104:
 				if !_rules[rule_]() {
-					goto l43
-				}
-				if !_rules[ruleLogicExpr]() {
-					goto l43
+					goto l94
 				}
-			l55:
-				{
-					position56, tokenIndex56 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l56
-					}
-					{
-						position57, tokenIndex57 := position, tokenIndex
-						if !_rules[ruleCOMMA]() {
-							goto l57
-						}
-						goto l58
-					l57:
-						position, tokenIndex = position57, tokenIndex57
-					}
-				l58:
-					if !_rules[ruleLogicExpr]() {
-						goto l56
-					}
-					goto l55
-				l56:
-					position, tokenIndex = position56, tokenIndex56
+				if !_rules[ruleFilters]() {
+					goto l94
 				}
-				add(ruleWhereExpr, position44)
+				add(ruleWhereExpr, position95)
 			}
 			return true
-		l43:
-			position, tokenIndex = position43, tokenIndex43
+		l94:
+			position, tokenIndex = position94, tokenIndex94
 			return false
 		},
-		/* 4 OrderByExpr <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') ' ' ('b' / 'B') ('y' / 'Y') _ Action2 Columns Descending?)> */
+		/* 7 OrderByExpr <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') ' ' ('b' / 'B') ('y' / 'Y') _ Action5 Columns (Descending / Ascending)?)> */
 		func() bool {
-			position59, tokenIndex59 := position, tokenIndex
+			position106, tokenIndex106 := position, tokenIndex
 			{
-				position60 := position
+				position107 := position
 				{
-					position61, tokenIndex61 := position, tokenIndex
+					position108, tokenIndex108 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l62
+						goto l109
 					}
 					position++
-					goto l61
-				l62:
-					position, tokenIndex = position61, tokenIndex61
+					goto l108
+				l109:
+					position, tokenIndex = position108, tokenIndex108
 					if buffer[position] != rune('O') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l61:
+			l108:
 				{
-					position63, tokenIndex63 := position, tokenIndex
+					position110, tokenIndex110 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l64
+						goto l111
 					}
 					position++
-					goto l63
-				l64:
-					position, tokenIndex = position63, tokenIndex63
+					goto l110
+				l111:
+					position, tokenIndex = position110, tokenIndex110
 					if buffer[position] != rune('R') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l63:
+			l110:
 				{
-					position65, tokenIndex65 := position, tokenIndex
+					position112, tokenIndex112 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l66
+						goto l113
 					}
 					position++
-					goto l65
-				l66:
-					position, tokenIndex = position65, tokenIndex65
+					goto l112
+				l113:
+					position, tokenIndex = position112, tokenIndex112
 					if buffer[position] != rune('D') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l65:
+			l112:
 				{
-					position67, tokenIndex67 := position, tokenIndex
+					position114, tokenIndex114 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l68
+						goto l115
 					}
 					position++
-					goto l67
-				l68:
-					position, tokenIndex = position67, tokenIndex67
+					goto l114
+				l115:
+					position, tokenIndex = position114, tokenIndex114
 					if buffer[position] != rune('E') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l67:
+			l114:
 				{
-					position69, tokenIndex69 := position, tokenIndex
+					position116, tokenIndex116 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l70
+						goto l117
 					}
 					position++
-					goto l69
-				l70:
-					position, tokenIndex = position69, tokenIndex69
+					goto l116
+				l117:
+					position, tokenIndex = position116, tokenIndex116
 					if buffer[position] != rune('R') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l69:
+			l116:
 				if buffer[position] != rune(' ') {
-					goto l59
+					goto l106
 				}
 				position++
 				{
-					position71, tokenIndex71 := position, tokenIndex
+					position118, tokenIndex118 := position, tokenIndex
 					if buffer[position] != rune('b') {
-						goto l72
+						goto l119
 					}
 					position++
-					goto l71
-				l72:
-					position, tokenIndex = position71, tokenIndex71
+					goto l118
+				l119:
+					position, tokenIndex = position118, tokenIndex118
 					if buffer[position] != rune('B') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l71:
+			l118:
 				{
-					position73, tokenIndex73 := position, tokenIndex
+					position120, tokenIndex120 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l74
+						goto l121
 					}
 					position++
-					goto l73
-				l74:
-					position, tokenIndex = position73, tokenIndex73
+					goto l120
+				l121:
+					position, tokenIndex = position120, tokenIndex120
 					if buffer[position] != rune('Y') {
-						goto l59
+						goto l106
 					}
 					position++
 				}
-			l73:
+			l120:
 				if !_rules[rule_]() {
-					goto l59
+					goto l106
 				}
-				if !_rules[ruleAction2]() {
-					goto l59
+				if !_rules[ruleAction5]() {
+					goto l106
 				}
 				if !_rules[ruleColumns]() {
-					goto l59
+					goto l106
 				}
 				{
-					position75, tokenIndex75 := position, tokenIndex
-					if !_rules[ruleDescending]() {
-						goto l75
+					position122, tokenIndex122 := position, tokenIndex
+					{
+						position124, tokenIndex124 := position, tokenIndex
+						if !_rules[ruleDescending]() {
+							goto l125
+						}
+						goto l124
+					l125:
+						position, tokenIndex = position124, tokenIndex124
+						if !_rules[ruleAscending]() {
+							goto l122
+						}
 					}
-					goto l76
-				l75:
-					position, tokenIndex = position75, tokenIndex75
+				l124:
+					goto l123
+				l122:
+					position, tokenIndex = position122, tokenIndex122
 				}
-			l76:
-				add(ruleOrderByExpr, position60)
+			l123:
+				add(ruleOrderByExpr, position107)
 			}
 			return true
-		l59:
-			position, tokenIndex = position59, tokenIndex59
+		l106:
+			position, tokenIndex = position106, tokenIndex106
 			return false
 		},
-		/* 5 LimitExpr <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') _ <Unsigned> Action3)> */
+		/* 8 LimitExpr <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') _ ((('a' / 'A') ('l' / 'L') ('l' / 'L') Action6) / (<Unsigned> Action7)))> */
 		func() bool {
-			position77, tokenIndex77 := position, tokenIndex
+			position126, tokenIndex126 := position, tokenIndex
 			{
-				position78 := position
+				position127 := position
 				{
-					position79, tokenIndex79 := position, tokenIndex
+					position128, tokenIndex128 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l80
+						goto l129
 					}
 					position++
-					goto l79
-				l80:
-					position, tokenIndex = position79, tokenIndex79
+					goto l128
+				l129:
+					position, tokenIndex = position128, tokenIndex128
 					if buffer[position] != rune('L') {
-						goto l77
+						goto l126
 					}
 					position++
 				}
-			l79:
+			l128:
 				{
-					position81, tokenIndex81 := position, tokenIndex
+					position130, tokenIndex130 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l82
+						goto l131
 					}
 					position++
-					goto l81
-				l82:
-					position, tokenIndex = position81, tokenIndex81
+					goto l130
+				l131:
+					position, tokenIndex = position130, tokenIndex130
 					if buffer[position] != rune('I') {
-						goto l77
+						goto l126
 					}
 					position++
 				}
-			l81:
+			l130:
 				{
-					position83, tokenIndex83 := position, tokenIndex
+					position132, tokenIndex132 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l84
+						goto l133
 					}
 					position++
-					goto l83
-				l84:
-					position, tokenIndex = position83, tokenIndex83
+					goto l132
+				l133:
+					position, tokenIndex = position132, tokenIndex132
 					if buffer[position] != rune('M') {
-						goto l77
+						goto l126
 					}
 					position++
 				}
-			l83:
+			l132:
 				{
-					position85, tokenIndex85 := position, tokenIndex
+					position134, tokenIndex134 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l86
+						goto l135
 					}
 					position++
-					goto l85
-				l86:
-					position, tokenIndex = position85, tokenIndex85
+					goto l134
+				l135:
+					position, tokenIndex = position134, tokenIndex134
 					if buffer[position] != rune('I') {
-						goto l77
+						goto l126
 					}
 					position++
 				}
-			l85:
+			l134:
 				{
-					position87, tokenIndex87 := position, tokenIndex
+					position136, tokenIndex136 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l88
+						goto l137
 					}
 					position++
-					goto l87
-				l88:
-					position, tokenIndex = position87, tokenIndex87
+					goto l136
+				l137:
+					position, tokenIndex = position136, tokenIndex136
 					if buffer[position] != rune('T') {
-						goto l77
+						goto l126
 					}
 					position++
 				}
-			l87:
+			l136:
 				if !_rules[rule_]() {
-					goto l77
+					goto l126
 				}
 				{
-					position89 := position
-					if !_rules[ruleUnsigned]() {
-						goto l77
+					position138, tokenIndex138 := position, tokenIndex
+					{
+						position140, tokenIndex140 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l141
+						}
+						position++
+						goto l140
+					l141:
+						position, tokenIndex = position140, tokenIndex140
+						if buffer[position] != rune('A') {
+							goto l139
+						}
+						position++
+					}
+				l140:
+					{
+						position142, tokenIndex142 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l143
+						}
+						position++
+						goto l142
+					l143:
+						position, tokenIndex = position142, tokenIndex142
+						if buffer[position] != rune('L') {
+							goto l139
+						}
+						position++
+					}
+				l142:
+					{
+						position144, tokenIndex144 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l145
+						}
+						position++
+						goto l144
+					l145:
+						position, tokenIndex = position144, tokenIndex144
+						if buffer[position] != rune('L') {
+							goto l139
+						}
+						position++
+					}
+				l144:
+					if !_rules[ruleAction6]() {
+						goto l139
+					}
+					goto l138
+				l139:
+					position, tokenIndex = position138, tokenIndex138
+					{
+						position146 := position
+						if !_rules[ruleUnsigned]() {
+							goto l126
+						}
+						add(rulePegText, position146)
+					}
+					if !_rules[ruleAction7]() {
+						goto l126
 					}
-					add(rulePegText, position89)
-				}
-				if !_rules[ruleAction3]() {
-					goto l77
 				}
-				add(ruleLimitExpr, position78)
+			l138:
+				add(ruleLimitExpr, position127)
 			}
 			return true
-		l77:
-			position, tokenIndex = position77, tokenIndex77
+		l126:
+			position, tokenIndex = position126, tokenIndex126
 			return false
 		},
-		/* 6 Columns <- <(Column (COMMA Column)*)> */
+		/* 9 OffsetExpr <- <(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') _ <Unsigned> Action8)> */
 		func() bool {
-			position90, tokenIndex90 := position, tokenIndex
+			position147, tokenIndex147 := position, tokenIndex
 			{
-				position91 := position
-				if !_rules[ruleColumn]() {
-					goto l90
+				position148 := position
+				{
+					position149, tokenIndex149 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l150
+					}
+					position++
+					goto l149
+				l150:
+					position, tokenIndex = position149, tokenIndex149
+					if buffer[position] != rune('O') {
+						goto l147
+					}
+					position++
 				}
-			l92:
+			l149:
 				{
-					position93, tokenIndex93 := position, tokenIndex
-					if !_rules[ruleCOMMA]() {
-						goto l93
+					position151, tokenIndex151 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l152
 					}
-					if !_rules[ruleColumn]() {
-						goto l93
+					position++
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
+					if buffer[position] != rune('F') {
+						goto l147
 					}
-					goto l92
-				l93:
-					position, tokenIndex = position93, tokenIndex93
+					position++
 				}
-				add(ruleColumns, position91)
-			}
+			l151:
+				{
+					position153, tokenIndex153 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l154
+					}
+					position++
+					goto l153
+				l154:
+					position, tokenIndex = position153, tokenIndex153
+					if buffer[position] != rune('F') {
+						goto l147
+					}
+					position++
+				}
+			l153:
+				{
+					position155, tokenIndex155 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l156
+					}
+					position++
+					goto l155
+				l156:
+					position, tokenIndex = position155, tokenIndex155
+					if buffer[position] != rune('S') {
+						goto l147
+					}
+					position++
+				}
+			l155:
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l158
+					}
+					position++
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
+					if buffer[position] != rune('E') {
+						goto l147
+					}
+					position++
+				}
+			l157:
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l160
+					}
+					position++
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
+					if buffer[position] != rune('T') {
+						goto l147
+					}
+					position++
+				}
+			l159:
+				if !_rules[rule_]() {
+					goto l147
+				}
+				{
+					position161 := position
+					if !_rules[ruleUnsigned]() {
+						goto l147
+					}
+					add(rulePegText, position161)
+				}
+				if !_rules[ruleAction8]() {
+					goto l147
+				}
+				add(ruleOffsetExpr, position148)
+			}
 			return true
-		l90:
-			position, tokenIndex = position90, tokenIndex90
+		l147:
+			position, tokenIndex = position147, tokenIndex147
 			return false
 		},
-		/* 7 Column <- <(Action4 (ColumnAggregation / (<Identifier> _ Action5) / (<'*'> _ Action6)))> */
+		/* 10 Columns <- <(Column (COMMA Column)*)> */
 		func() bool {
-			position94, tokenIndex94 := position, tokenIndex
+			position162, tokenIndex162 := position, tokenIndex
 			{
-				position95 := position
-				if !_rules[ruleAction4]() {
-					goto l94
+				position163 := position
+				if !_rules[ruleColumn]() {
+					goto l162
 				}
+			l164:
 				{
-					position96, tokenIndex96 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l165
+					}
+					if !_rules[ruleColumn]() {
+						goto l165
+					}
+					goto l164
+				l165:
+					position, tokenIndex = position165, tokenIndex165
+				}
+				add(ruleColumns, position163)
+			}
+			return true
+		l162:
+			position, tokenIndex = position162, tokenIndex162
+			return false
+		},
+		/* 11 Column <- <(Action9 (ConditionalAggregation / ColumnAggregation / (<Identifier> Action10 _) / (<'*'> Action11 _)) ColumnAlias?)> */
+		func() bool {
+			position166, tokenIndex166 := position, tokenIndex
+			{
+				position167 := position
+				if !_rules[ruleAction9]() {
+					goto l166
+				}
+				{
+					position168, tokenIndex168 := position, tokenIndex
+					if !_rules[ruleConditionalAggregation]() {
+						goto l169
+					}
+					goto l168
+				l169:
+					position, tokenIndex = position168, tokenIndex168
 					if !_rules[ruleColumnAggregation]() {
-						goto l97
+						goto l170
 					}
-					goto l96
-				l97:
-					position, tokenIndex = position96, tokenIndex96
+					goto l168
+				l170:
+					position, tokenIndex = position168, tokenIndex168
 					{
-						position99 := position
+						position172 := position
 						if !_rules[ruleIdentifier]() {
-							goto l98
+							goto l171
 						}
-						add(rulePegText, position99)
+						add(rulePegText, position172)
 					}
-					if !_rules[rule_]() {
-						goto l98
+					if !_rules[ruleAction10]() {
+						goto l171
 					}
-					if !_rules[ruleAction5]() {
-						goto l98
+					if !_rules[rule_]() {
+						goto l171
 					}
-					goto l96
-				l98:
-					position, tokenIndex = position96, tokenIndex96
+					goto l168
+				l171:
+					position, tokenIndex = position168, tokenIndex168
 					{
-						position100 := position
+						position173 := position
 						if buffer[position] != rune('*') {
-							goto l94
+							goto l166
 						}
 						position++
-						add(rulePegText, position100)
+						add(rulePegText, position173)
+					}
+					if !_rules[ruleAction11]() {
+						goto l166
 					}
 					if !_rules[rule_]() {
-						goto l94
+						goto l166
 					}
-					if !_rules[ruleAction6]() {
-						goto l94
+				}
+			l168:
+				{
+					position174, tokenIndex174 := position, tokenIndex
+					if !_rules[ruleColumnAlias]() {
+						goto l174
 					}
+					goto l175
+				l174:
+					position, tokenIndex = position174, tokenIndex174
 				}
-			l96:
-				add(ruleColumn, position95)
+			l175:
+				add(ruleColumn, position167)
 			}
 			return true
-		l94:
-			position, tokenIndex = position94, tokenIndex94
+		l166:
+			position, tokenIndex = position166, tokenIndex166
 			return false
 		},
-		/* 8 ColumnAggregation <- <(<Identifier> Action7 LPAR <Identifier> RPAR Action8)> */
+		/* 12 ColumnAlias <- <(('a' / 'A') ('s' / 'S') !IdChar _ <Identifier> Action12 _)> */
 		func() bool {
-			position101, tokenIndex101 := position, tokenIndex
+			position176, tokenIndex176 := position, tokenIndex
 			{
-				position102 := position
+				position177 := position
 				{
-					position103 := position
-					if !_rules[ruleIdentifier]() {
-						goto l101
+					position178, tokenIndex178 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l179
+					}
+					position++
+					goto l178
+				l179:
+					position, tokenIndex = position178, tokenIndex178
+					if buffer[position] != rune('A') {
+						goto l176
+					}
+					position++
+				}
+			l178:
+				{
+					position180, tokenIndex180 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l181
+					}
+					position++
+					goto l180
+				l181:
+					position, tokenIndex = position180, tokenIndex180
+					if buffer[position] != rune('S') {
+						goto l176
 					}
-					add(rulePegText, position103)
+					position++
 				}
-				if !_rules[ruleAction7]() {
-					goto l101
+			l180:
+				{
+					position182, tokenIndex182 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l182
+					}
+					goto l176
+				l182:
+					position, tokenIndex = position182, tokenIndex182
 				}
-				if !_rules[ruleLPAR]() {
-					goto l101
+				if !_rules[rule_]() {
+					goto l176
 				}
 				{
-					position104 := position
+					position183 := position
 					if !_rules[ruleIdentifier]() {
-						goto l101
+						goto l176
 					}
-					add(rulePegText, position104)
+					add(rulePegText, position183)
 				}
-				if !_rules[ruleRPAR]() {
-					goto l101
+				if !_rules[ruleAction12]() {
+					goto l176
 				}
-				if !_rules[ruleAction8]() {
-					goto l101
+				if !_rules[rule_]() {
+					goto l176
 				}
-				add(ruleColumnAggregation, position102)
+				add(ruleColumnAlias, position177)
 			}
 			return true
-		l101:
-			position, tokenIndex = position101, tokenIndex101
+		l176:
+			position, tokenIndex = position176, tokenIndex176
 			return false
 		},
-		/* 9 LogicExpr <- <((LPAR LogicExpr RPAR) / (Action9 FilterKey _ FilterOperator _ FilterValue))> */
+		/* 13 ColumnAggregation <- <(<Identifier> Action13 LPAR <(Identifier / '*')> Action14 (COMMA <Identifier> Action15)* (COMMA ColumnValue)* RPAR)> */
 		func() bool {
-			position105, tokenIndex105 := position, tokenIndex
+			position184, tokenIndex184 := position, tokenIndex
 			{
-				position106 := position
+				position185 := position
 				{
-					position107, tokenIndex107 := position, tokenIndex
-					if !_rules[ruleLPAR]() {
-						goto l108
-					}
-					if !_rules[ruleLogicExpr]() {
-						goto l108
-					}
-					if !_rules[ruleRPAR]() {
-						goto l108
+					position186 := position
+					if !_rules[ruleIdentifier]() {
+						goto l184
 					}
-					goto l107
-				l108:
-					position, tokenIndex = position107, tokenIndex107
-					if !_rules[ruleAction9]() {
-						goto l105
+					add(rulePegText, position186)
+				}
+				if !_rules[ruleAction13]() {
+					goto l184
+				}
+				if !_rules[ruleLPAR]() {
+					goto l184
+				}
+				{
+					position187 := position
+					{
+						position188, tokenIndex188 := position, tokenIndex
+						if !_rules[ruleIdentifier]() {
+							goto l189
+						}
+						goto l188
+					l189:
+						position, tokenIndex = position188, tokenIndex188
+						if buffer[position] != rune('*') {
+							goto l184
+						}
+						position++
 					}
-					if !_rules[ruleFilterKey]() {
-						goto l105
+				l188:
+					add(rulePegText, position187)
+				}
+				if !_rules[ruleAction14]() {
+					goto l184
+				}
+			l190:
+				{
+					position191, tokenIndex191 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l191
 					}
-					if !_rules[rule_]() {
-						goto l105
+					{
+						position192 := position
+						if !_rules[ruleIdentifier]() {
+							goto l191
+						}
+						add(rulePegText, position192)
 					}
-					if !_rules[ruleFilterOperator]() {
-						goto l105
+					if !_rules[ruleAction15]() {
+						goto l191
 					}
-					if !_rules[rule_]() {
-						goto l105
+					goto l190
+				l191:
+					position, tokenIndex = position191, tokenIndex191
+				}
+			l193:
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l194
 					}
-					if !_rules[ruleFilterValue]() {
-						goto l105
+					if !_rules[ruleColumnValue]() {
+						goto l194
 					}
+					goto l193
+				l194:
+					position, tokenIndex = position194, tokenIndex194
+				}
+				if !_rules[ruleRPAR]() {
+					goto l184
 				}
-			l107:
-				add(ruleLogicExpr, position106)
+				add(ruleColumnAggregation, position185)
 			}
 			return true
-		l105:
-			position, tokenIndex = position105, tokenIndex105
+		l184:
+			position, tokenIndex = position184, tokenIndex184
 			return false
 		},
-		/* 10 OPERATOR <- <('=' / ('!' '=') / ('<' '=') / ('>' '=') / '<' / '>' / (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')))> */
+		/* 14 ColumnValue <- <((<Float> Action16) / (<Integer> Action17) / (<String> Action18) / (<((('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E')) / (('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E')))> !IdChar Action19))> */
 		func() bool {
-			position109, tokenIndex109 := position, tokenIndex
+			position195, tokenIndex195 := position, tokenIndex
 			{
-				position110 := position
+				position196 := position
 				{
-					position111, tokenIndex111 := position, tokenIndex
-					if buffer[position] != rune('=') {
-						goto l112
+					position197, tokenIndex197 := position, tokenIndex
+					{
+						position199 := position
+						if !_rules[ruleFloat]() {
+							goto l198
+						}
+						add(rulePegText, position199)
 					}
-					position++
-					goto l111
-				l112:
-					position, tokenIndex = position111, tokenIndex111
-					if buffer[position] != rune('!') {
-						goto l113
+					if !_rules[ruleAction16]() {
+						goto l198
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l113
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
+					{
+						position201 := position
+						if !_rules[ruleInteger]() {
+							goto l200
+						}
+						add(rulePegText, position201)
 					}
-					position++
-					goto l111
-				l113:
-					position, tokenIndex = position111, tokenIndex111
-					if buffer[position] != rune('<') {
-						goto l114
+					if !_rules[ruleAction17]() {
+						goto l200
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l114
+					goto l197
+				l200:
+					position, tokenIndex = position197, tokenIndex197
+					{
+						position203 := position
+						if !_rules[ruleString]() {
+							goto l202
+						}
+						add(rulePegText, position203)
 					}
-					position++
-					goto l111
-				l114:
-					position, tokenIndex = position111, tokenIndex111
-					if buffer[position] != rune('>') {
-						goto l115
+					if !_rules[ruleAction18]() {
+						goto l202
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l115
+					goto l197
+				l202:
+					position, tokenIndex = position197, tokenIndex197
+					{
+						position204 := position
+						{
+							position205, tokenIndex205 := position, tokenIndex
+							{
+								position207, tokenIndex207 := position, tokenIndex
+								if buffer[position] != rune('t') {
+									goto l208
+								}
+								position++
+								goto l207
+							l208:
+								position, tokenIndex = position207, tokenIndex207
+								if buffer[position] != rune('T') {
+									goto l206
+								}
+								position++
+							}
+						l207:
+							{
+								position209, tokenIndex209 := position, tokenIndex
+								if buffer[position] != rune('r') {
+									goto l210
+								}
+								position++
+								goto l209
+							l210:
+								position, tokenIndex = position209, tokenIndex209
+								if buffer[position] != rune('R') {
+									goto l206
+								}
+								position++
+							}
+						l209:
+							{
+								position211, tokenIndex211 := position, tokenIndex
+								if buffer[position] != rune('u') {
+									goto l212
+								}
+								position++
+								goto l211
+							l212:
+								position, tokenIndex = position211, tokenIndex211
+								if buffer[position] != rune('U') {
+									goto l206
+								}
+								position++
+							}
+						l211:
+							{
+								position213, tokenIndex213 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l214
+								}
+								position++
+								goto l213
+							l214:
+								position, tokenIndex = position213, tokenIndex213
+								if buffer[position] != rune('E') {
+									goto l206
+								}
+								position++
+							}
+						l213:
+							goto l205
+						l206:
+							position, tokenIndex = position205, tokenIndex205
+							{
+								position215, tokenIndex215 := position, tokenIndex
+								if buffer[position] != rune('f') {
+									goto l216
+								}
+								position++
+								goto l215
+							l216:
+								position, tokenIndex = position215, tokenIndex215
+								if buffer[position] != rune('F') {
+									goto l195
+								}
+								position++
+							}
+						l215:
+							{
+								position217, tokenIndex217 := position, tokenIndex
+								if buffer[position] != rune('a') {
+									goto l218
+								}
+								position++
+								goto l217
+							l218:
+								position, tokenIndex = position217, tokenIndex217
+								if buffer[position] != rune('A') {
+									goto l195
+								}
+								position++
+							}
+						l217:
+							{
+								position219, tokenIndex219 := position, tokenIndex
+								if buffer[position] != rune('l') {
+									goto l220
+								}
+								position++
+								goto l219
+							l220:
+								position, tokenIndex = position219, tokenIndex219
+								if buffer[position] != rune('L') {
+									goto l195
+								}
+								position++
+							}
+						l219:
+							{
+								position221, tokenIndex221 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l222
+								}
+								position++
+								goto l221
+							l222:
+								position, tokenIndex = position221, tokenIndex221
+								if buffer[position] != rune('S') {
+									goto l195
+								}
+								position++
+							}
+						l221:
+							{
+								position223, tokenIndex223 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l224
+								}
+								position++
+								goto l223
+							l224:
+								position, tokenIndex = position223, tokenIndex223
+								if buffer[position] != rune('E') {
+									goto l195
+								}
+								position++
+							}
+						l223:
+						}
+					l205:
+						add(rulePegText, position204)
 					}
-					position++
-					goto l111
-				l115:
-					position, tokenIndex = position111, tokenIndex111
-					if buffer[position] != rune('<') {
-						goto l116
+					{
+						position225, tokenIndex225 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l225
+						}
+						goto l195
+					l225:
+						position, tokenIndex = position225, tokenIndex225
 					}
-					position++
-					goto l111
-				l116:
-					position, tokenIndex = position111, tokenIndex111
-					if buffer[position] != rune('>') {
-						goto l117
+					if !_rules[ruleAction19]() {
+						goto l195
 					}
-					position++
-					goto l111
-				l117:
-					position, tokenIndex = position111, tokenIndex111
+				}
+			l197:
+				add(ruleColumnValue, position196)
+			}
+			return true
+		l195:
+			position, tokenIndex = position195, tokenIndex195
+			return false
+		},
+		/* 15 ConditionalAggregation <- <(<(('c' / 'C') ('o' / 'O') ('u' / 'U') ('n' / 'N') ('t' / 'T') '_' ('i' / 'I') ('f' / 'F'))> Action20 LPAR Action21 Filters RPAR Action22)> */
+		func() bool {
+			position226, tokenIndex226 := position, tokenIndex
+			{
+				position227 := position
+				{
+					position228 := position
 					{
-						position118, tokenIndex118 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l119
+						position229, tokenIndex229 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l230
 						}
 						position++
-						goto l118
-					l119:
-						position, tokenIndex = position118, tokenIndex118
-						if buffer[position] != rune('M') {
-							goto l109
+						goto l229
+					l230:
+						position, tokenIndex = position229, tokenIndex229
+						if buffer[position] != rune('C') {
+							goto l226
 						}
 						position++
 					}
-				l118:
+				l229:
 					{
-						position120, tokenIndex120 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l121
+						position231, tokenIndex231 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l232
 						}
 						position++
-						goto l120
-					l121:
-						position, tokenIndex = position120, tokenIndex120
-						if buffer[position] != rune('A') {
-							goto l109
+						goto l231
+					l232:
+						position, tokenIndex = position231, tokenIndex231
+						if buffer[position] != rune('O') {
+							goto l226
 						}
 						position++
 					}
-				l120:
+				l231:
 					{
-						position122, tokenIndex122 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l123
+						position233, tokenIndex233 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l234
 						}
 						position++
-						goto l122
-					l123:
-						position, tokenIndex = position122, tokenIndex122
-						if buffer[position] != rune('T') {
-							goto l109
+						goto l233
+					l234:
+						position, tokenIndex = position233, tokenIndex233
+						if buffer[position] != rune('U') {
+							goto l226
 						}
 						position++
 					}
-				l122:
+				l233:
 					{
-						position124, tokenIndex124 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l125
+						position235, tokenIndex235 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l236
 						}
 						position++
-						goto l124
-					l125:
-						position, tokenIndex = position124, tokenIndex124
-						if buffer[position] != rune('C') {
-							goto l109
+						goto l235
+					l236:
+						position, tokenIndex = position235, tokenIndex235
+						if buffer[position] != rune('N') {
+							goto l226
 						}
 						position++
 					}
-				l124:
+				l235:
 					{
-						position126, tokenIndex126 := position, tokenIndex
-						if buffer[position] != rune('h') {
-							goto l127
+						position237, tokenIndex237 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l238
 						}
 						position++
-						goto l126
-					l127:
-						position, tokenIndex = position126, tokenIndex126
-						if buffer[position] != rune('H') {
-							goto l109
+						goto l237
+					l238:
+						position, tokenIndex = position237, tokenIndex237
+						if buffer[position] != rune('T') {
+							goto l226
 						}
 						position++
 					}
-				l126:
+				l237:
+					if buffer[position] != rune('_') {
+						goto l226
+					}
+					position++
 					{
-						position128, tokenIndex128 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l129
+						position239, tokenIndex239 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l240
 						}
 						position++
-						goto l128
-					l129:
-						position, tokenIndex = position128, tokenIndex128
-						if buffer[position] != rune('E') {
-							goto l109
+						goto l239
+					l240:
+						position, tokenIndex = position239, tokenIndex239
+						if buffer[position] != rune('I') {
+							goto l226
 						}
 						position++
 					}
-				l128:
+				l239:
 					{
-						position130, tokenIndex130 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l131
+						position241, tokenIndex241 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l242
 						}
 						position++
-						goto l130
-					l131:
-						position, tokenIndex = position130, tokenIndex130
-						if buffer[position] != rune('S') {
-							goto l109
+						goto l241
+					l242:
+						position, tokenIndex = position241, tokenIndex241
+						if buffer[position] != rune('F') {
+							goto l226
 						}
 						position++
 					}
-				l130:
+				l241:
+					add(rulePegText, position228)
 				}
-			l111:
-				add(ruleOPERATOR, position110)
-			}
-			return true
-		l109:
-			position, tokenIndex = position109, tokenIndex109
-			return false
-		},
-		/* 11 FilterKey <- <(<Identifier> Action10)> */
-		func() bool {
-			position132, tokenIndex132 := position, tokenIndex
-			{
-				position133 := position
-				{
-					position134 := position
-					if !_rules[ruleIdentifier]() {
-						goto l132
-					}
-					add(rulePegText, position134)
+				if !_rules[ruleAction20]() {
+					goto l226
 				}
-				if !_rules[ruleAction10]() {
-					goto l132
+				if !_rules[ruleLPAR]() {
+					goto l226
+				}
+				if !_rules[ruleAction21]() {
+					goto l226
+				}
+				if !_rules[ruleFilters]() {
+					goto l226
+				}
+				if !_rules[ruleRPAR]() {
+					goto l226
+				}
+				if !_rules[ruleAction22]() {
+					goto l226
 				}
-				add(ruleFilterKey, position133)
+				add(ruleConditionalAggregation, position227)
 			}
 			return true
-		l132:
-			position, tokenIndex = position132, tokenIndex132
+		l226:
+			position, tokenIndex = position226, tokenIndex226
 			return false
 		},
-		/* 12 FilterOperator <- <(<OPERATOR> Action11)> */
+		/* 16 Filters <- <(Disjunction (_ COMMA? Disjunction)*)> */
 		func() bool {
-			position135, tokenIndex135 := position, tokenIndex
+			position243, tokenIndex243 := position, tokenIndex
 			{
-				position136 := position
+				position244 := position
+				if !_rules[ruleDisjunction]() {
+					goto l243
+				}
+			l245:
 				{
-					position137 := position
-					if !_rules[ruleOPERATOR]() {
-						goto l135
+					position246, tokenIndex246 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l246
 					}
-					add(rulePegText, position137)
-				}
-				if !_rules[ruleAction11]() {
-					goto l135
+					{
+						position247, tokenIndex247 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l247
+						}
+						goto l248
+					l247:
+						position, tokenIndex = position247, tokenIndex247
+					}
+				l248:
+					if !_rules[ruleDisjunction]() {
+						goto l246
+					}
+					goto l245
+				l246:
+					position, tokenIndex = position246, tokenIndex246
 				}
-				add(ruleFilterOperator, position136)
+				add(ruleFilters, position244)
 			}
 			return true
-		l135:
-			position, tokenIndex = position135, tokenIndex135
+		l243:
+			position, tokenIndex = position243, tokenIndex243
 			return false
 		},
-		/* 13 FilterValue <- <((<Float> Action12) / (<Integer> Action13) / (<String> Action14))> */
+		/* 17 Disjunction <- <(Action23 Conjunction (_ (('o' / 'O') ('r' / 'R')) !IdChar _ Action24 Conjunction)* Action25)> */
 		func() bool {
-			position138, tokenIndex138 := position, tokenIndex
+			position249, tokenIndex249 := position, tokenIndex
 			{
-				position139 := position
+				position250 := position
+				if !_rules[ruleAction23]() {
+					goto l249
+				}
+				if !_rules[ruleConjunction]() {
+					goto l249
+				}
+			l251:
 				{
-					position140, tokenIndex140 := position, tokenIndex
+					position252, tokenIndex252 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l252
+					}
 					{
-						position142 := position
-						if !_rules[ruleFloat]() {
-							goto l141
+						position253, tokenIndex253 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l254
 						}
-						add(rulePegText, position142)
-					}
-					if !_rules[ruleAction12]() {
-						goto l141
+						position++
+						goto l253
+					l254:
+						position, tokenIndex = position253, tokenIndex253
+						if buffer[position] != rune('O') {
+							goto l252
+						}
+						position++
 					}
-					goto l140
-				l141:
-					position, tokenIndex = position140, tokenIndex140
+				l253:
 					{
-						position144 := position
-						if !_rules[ruleInteger]() {
-							goto l143
+						position255, tokenIndex255 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l256
 						}
-						add(rulePegText, position144)
-					}
-					if !_rules[ruleAction13]() {
-						goto l143
+						position++
+						goto l255
+					l256:
+						position, tokenIndex = position255, tokenIndex255
+						if buffer[position] != rune('R') {
+							goto l252
+						}
+						position++
 					}
-					goto l140
-				l143:
-					position, tokenIndex = position140, tokenIndex140
+				l255:
 					{
-						position145 := position
-						if !_rules[ruleString]() {
-							goto l138
+						position257, tokenIndex257 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l257
 						}
-						add(rulePegText, position145)
+						goto l252
+					l257:
+						position, tokenIndex = position257, tokenIndex257
+					}
+					if !_rules[rule_]() {
+						goto l252
+					}
+					if !_rules[ruleAction24]() {
+						goto l252
 					}
-					if !_rules[ruleAction14]() {
-						goto l138
+					if !_rules[ruleConjunction]() {
+						goto l252
 					}
+					goto l251
+				l252:
+					position, tokenIndex = position252, tokenIndex252
+				}
+				if !_rules[ruleAction25]() {
+					goto l249
 				}
-			l140:
-				add(ruleFilterValue, position139)
+				add(ruleDisjunction, position250)
 			}
 			return true
-		l138:
-			position, tokenIndex = position138, tokenIndex138
+		l249:
+			position, tokenIndex = position249, tokenIndex249
 			return false
 		},
-		/* 14 Descending <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') Action15)> */
+		/* 18 Conjunction <- <(FilterTerm (_ (('a' / 'A') ('n' / 'N') ('d' / 'D')) !IdChar _ FilterTerm)*)> */
 		func() bool {
-			position146, tokenIndex146 := position, tokenIndex
+			position258, tokenIndex258 := position, tokenIndex
 			{
-				position147 := position
-				{
-					position148, tokenIndex148 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l149
-					}
-					position++
-					goto l148
-				l149:
-					position, tokenIndex = position148, tokenIndex148
-					if buffer[position] != rune('D') {
-						goto l146
-					}
-					position++
+				position259 := position
+				if !_rules[ruleFilterTerm]() {
+					goto l258
 				}
-			l148:
+			l260:
 				{
-					position150, tokenIndex150 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l151
+					position261, tokenIndex261 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l261
 					}
-					position++
-					goto l150
-				l151:
-					position, tokenIndex = position150, tokenIndex150
-					if buffer[position] != rune('E') {
-						goto l146
+					{
+						position262, tokenIndex262 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l263
+						}
+						position++
+						goto l262
+					l263:
+						position, tokenIndex = position262, tokenIndex262
+						if buffer[position] != rune('A') {
+							goto l261
+						}
+						position++
 					}
-					position++
-				}
-			l150:
-				{
-					position152, tokenIndex152 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l153
+				l262:
+					{
+						position264, tokenIndex264 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l265
+						}
+						position++
+						goto l264
+					l265:
+						position, tokenIndex = position264, tokenIndex264
+						if buffer[position] != rune('N') {
+							goto l261
+						}
+						position++
 					}
-					position++
-					goto l152
-				l153:
-					position, tokenIndex = position152, tokenIndex152
-					if buffer[position] != rune('S') {
-						goto l146
+				l264:
+					{
+						position266, tokenIndex266 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l267
+						}
+						position++
+						goto l266
+					l267:
+						position, tokenIndex = position266, tokenIndex266
+						if buffer[position] != rune('D') {
+							goto l261
+						}
+						position++
 					}
-					position++
-				}
-			l152:
-				{
-					position154, tokenIndex154 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l155
+				l266:
+					{
+						position268, tokenIndex268 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l268
+						}
+						goto l261
+					l268:
+						position, tokenIndex = position268, tokenIndex268
 					}
-					position++
-					goto l154
-				l155:
-					position, tokenIndex = position154, tokenIndex154
-					if buffer[position] != rune('C') {
-						goto l146
+					if !_rules[rule_]() {
+						goto l261
 					}
-					position++
-				}
-			l154:
-				if !_rules[ruleAction15]() {
-					goto l146
+					if !_rules[ruleFilterTerm]() {
+						goto l261
+					}
+					goto l260
+				l261:
+					position, tokenIndex = position261, tokenIndex261
 				}
-				add(ruleDescending, position147)
+				add(ruleConjunction, position259)
 			}
 			return true
-		l146:
-			position, tokenIndex = position146, tokenIndex146
+		l258:
+			position, tokenIndex = position258, tokenIndex258
 			return false
 		},
-		/* 15 String <- <('"' <StringChar*> '"')+> */
+		/* 19 FilterTerm <- <((LPAR Filters RPAR) / LogicExpr)> */
 		func() bool {
-			position156, tokenIndex156 := position, tokenIndex
+			position269, tokenIndex269 := position, tokenIndex
 			{
-				position157 := position
-				if buffer[position] != rune('"') {
-					goto l156
-				}
-				position++
+				position270 := position
 				{
-					position160 := position
-				l161:
-					{
-						position162, tokenIndex162 := position, tokenIndex
-						if !_rules[ruleStringChar]() {
-							goto l162
-						}
-						goto l161
-					l162:
-						position, tokenIndex = position162, tokenIndex162
+					position271, tokenIndex271 := position, tokenIndex
+					if !_rules[ruleLPAR]() {
+						goto l272
 					}
-					add(rulePegText, position160)
-				}
-				if buffer[position] != rune('"') {
-					goto l156
-				}
-				position++
-			l158:
-				{
-					position159, tokenIndex159 := position, tokenIndex
-					if buffer[position] != rune('"') {
-						goto l159
+					if !_rules[ruleFilters]() {
+						goto l272
 					}
-					position++
-					{
-						position163 := position
-					l164:
-						{
-							position165, tokenIndex165 := position, tokenIndex
-							if !_rules[ruleStringChar]() {
-								goto l165
-							}
-							goto l164
-						l165:
-							position, tokenIndex = position165, tokenIndex165
-						}
-						add(rulePegText, position163)
+					if !_rules[ruleRPAR]() {
+						goto l272
 					}
-					if buffer[position] != rune('"') {
-						goto l159
+					goto l271
+				l272:
+					position, tokenIndex = position271, tokenIndex271
+					if !_rules[ruleLogicExpr]() {
+						goto l269
 					}
-					position++
-					goto l158
-				l159:
-					position, tokenIndex = position159, tokenIndex159
 				}
-				add(ruleString, position157)
+			l271:
+				add(ruleFilterTerm, position270)
 			}
 			return true
-		l156:
-			position, tokenIndex = position156, tokenIndex156
+		l269:
+			position, tokenIndex = position269, tokenIndex269
 			return false
 		},
-		/* 16 StringChar <- <(Escape / (!('"' / '\n' / '\\') .))> */
+		/* 20 LogicExpr <- <((Action26 SampleExpr) / (Action27 <Quantifier> Action28 LPAR FilterKey _ FilterComparison RPAR) / (Action29 FilterKey _ FilterComparison))> */
 		func() bool {
-			position166, tokenIndex166 := position, tokenIndex
+			position273, tokenIndex273 := position, tokenIndex
 			{
-				position167 := position
+				position274 := position
 				{
-					position168, tokenIndex168 := position, tokenIndex
-					if !_rules[ruleEscape]() {
-						goto l169
+					position275, tokenIndex275 := position, tokenIndex
+					if !_rules[ruleAction26]() {
+						goto l276
+					}
+					if !_rules[ruleSampleExpr]() {
+						goto l276
+					}
+					goto l275
+				l276:
+					position, tokenIndex = position275, tokenIndex275
+					if !_rules[ruleAction27]() {
+						goto l277
 					}
-					goto l168
-				l169:
-					position, tokenIndex = position168, tokenIndex168
 					{
-						position170, tokenIndex170 := position, tokenIndex
-						{
-							position171, tokenIndex171 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l172
-							}
-							position++
-							goto l171
-						l172:
-							position, tokenIndex = position171, tokenIndex171
-							if buffer[position] != rune('\n') {
-								goto l173
-							}
-							position++
-							goto l171
-						l173:
-							position, tokenIndex = position171, tokenIndex171
-							if buffer[position] != rune('\\') {
-								goto l170
-							}
-							position++
+						position278 := position
+						if !_rules[ruleQuantifier]() {
+							goto l277
 						}
-					l171:
-						goto l166
-					l170:
-						position, tokenIndex = position170, tokenIndex170
+						add(rulePegText, position278)
 					}
-					if !matchDot() {
-						goto l166
+					if !_rules[ruleAction28]() {
+						goto l277
+					}
+					if !_rules[ruleLPAR]() {
+						goto l277
+					}
+					if !_rules[ruleFilterKey]() {
+						goto l277
+					}
+					if !_rules[rule_]() {
+						goto l277
+					}
+					if !_rules[ruleFilterComparison]() {
+						goto l277
+					}
+					if !_rules[ruleRPAR]() {
+						goto l277
+					}
+					goto l275
+				l277:
+					position, tokenIndex = position275, tokenIndex275
+					if !_rules[ruleAction29]() {
+						goto l273
+					}
+					if !_rules[ruleFilterKey]() {
+						goto l273
+					}
+					if !_rules[rule_]() {
+						goto l273
+					}
+					if !_rules[ruleFilterComparison]() {
+						goto l273
 					}
 				}
-			l168:
-				add(ruleStringChar, position167)
+			l275:
+				add(ruleLogicExpr, position274)
 			}
 			return true
-		l166:
-			position, tokenIndex = position166, tokenIndex166
+		l273:
+			position, tokenIndex = position273, tokenIndex273
 			return false
 		},
-		/* 17 Escape <- <(SimpleEscape / OctalEscape / HexEscape / UniversalCharacter)> */
+		/* 21 FilterComparison <- <(FilterInList / FilterBetween / FilterIsNull / (FilterOperator _ FilterValues))> */
 		func() bool {
-			position174, tokenIndex174 := position, tokenIndex
+			position279, tokenIndex279 := position, tokenIndex
 			{
-				position175 := position
+				position280 := position
 				{
-					position176, tokenIndex176 := position, tokenIndex
-					if !_rules[ruleSimpleEscape]() {
-						goto l177
-					}
-					goto l176
-				l177:
-					position, tokenIndex = position176, tokenIndex176
-					if !_rules[ruleOctalEscape]() {
-						goto l178
+					position281, tokenIndex281 := position, tokenIndex
+					if !_rules[ruleFilterInList]() {
+						goto l282
+					}
+					goto l281
+				l282:
+					position, tokenIndex = position281, tokenIndex281
+					if !_rules[ruleFilterBetween]() {
+						goto l283
+					}
+					goto l281
+				l283:
+					position, tokenIndex = position281, tokenIndex281
+					if !_rules[ruleFilterIsNull]() {
+						goto l284
+					}
+					goto l281
+				l284:
+					position, tokenIndex = position281, tokenIndex281
+					if !_rules[ruleFilterOperator]() {
+						goto l279
 					}
-					goto l176
-				l178:
-					position, tokenIndex = position176, tokenIndex176
-					if !_rules[ruleHexEscape]() {
-						goto l179
+					if !_rules[rule_]() {
+						goto l279
 					}
-					goto l176
-				l179:
-					position, tokenIndex = position176, tokenIndex176
-					if !_rules[ruleUniversalCharacter]() {
-						goto l174
+					if !_rules[ruleFilterValues]() {
+						goto l279
 					}
 				}
-			l176:
-				add(ruleEscape, position175)
+			l281:
+				add(ruleFilterComparison, position280)
 			}
 			return true
-		l174:
-			position, tokenIndex = position174, tokenIndex174
+		l279:
+			position, tokenIndex = position279, tokenIndex279
 			return false
 		},
-		/* 18 SimpleEscape <- <('\\' ('\'' / '"' / '?' / '\\' / 'a' / 'b' / 'f' / 'n' / 'r' / 't' / 'v'))> */
+		/* 22 FilterInList <- <(<(('i' / 'I') ('n' / 'N'))> !IdChar Action30 LPAR Action31 (FilterValue Action32 (COMMA FilterValue Action33)*)? RPAR Action34)> */
 		func() bool {
-			position180, tokenIndex180 := position, tokenIndex
+			position285, tokenIndex285 := position, tokenIndex
 			{
-				position181 := position
-				if buffer[position] != rune('\\') {
-					goto l180
-				}
-				position++
+				position286 := position
 				{
-					position182, tokenIndex182 := position, tokenIndex
-					if buffer[position] != rune('\'') {
-						goto l183
+					position287 := position
+					{
+						position288, tokenIndex288 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l289
+						}
+						position++
+						goto l288
+					l289:
+						position, tokenIndex = position288, tokenIndex288
+						if buffer[position] != rune('I') {
+							goto l285
+						}
+						position++
 					}
-					position++
-					goto l182
-				l183:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('"') {
-						goto l184
+				l288:
+					{
+						position290, tokenIndex290 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l291
+						}
+						position++
+						goto l290
+					l291:
+						position, tokenIndex = position290, tokenIndex290
+						if buffer[position] != rune('N') {
+							goto l285
+						}
+						position++
 					}
-					position++
-					goto l182
-				l184:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('?') {
-						goto l185
+				l290:
+					add(rulePegText, position287)
+				}
+				{
+					position292, tokenIndex292 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l292
 					}
-					position++
-					goto l182
-				l185:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('\\') {
-						goto l186
-					}
-					position++
-					goto l182
-				l186:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('a') {
-						goto l187
-					}
-					position++
-					goto l182
-				l187:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('b') {
-						goto l188
-					}
-					position++
-					goto l182
-				l188:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('f') {
-						goto l189
-					}
-					position++
-					goto l182
-				l189:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('n') {
-						goto l190
-					}
-					position++
-					goto l182
-				l190:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('r') {
-						goto l191
-					}
-					position++
-					goto l182
-				l191:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('t') {
-						goto l192
-					}
-					position++
-					goto l182
-				l192:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('v') {
-						goto l180
-					}
-					position++
+					goto l285
+				l292:
+					position, tokenIndex = position292, tokenIndex292
 				}
-			l182:
-				add(ruleSimpleEscape, position181)
-			}
-			return true
-		l180:
-			position, tokenIndex = position180, tokenIndex180
-			return false
-		},
-		/* 19 OctalEscape <- <('\\' [0-7] [0-7]? [0-7]?)> */
-		func() bool {
-			position193, tokenIndex193 := position, tokenIndex
-			{
-				position194 := position
-				if buffer[position] != rune('\\') {
-					goto l193
+				if !_rules[ruleAction30]() {
+					goto l285
 				}
-				position++
-				if c := buffer[position]; c < rune('0') || c > rune('7') {
-					goto l193
+				if !_rules[ruleLPAR]() {
+					goto l285
 				}
-				position++
-				{
-					position195, tokenIndex195 := position, tokenIndex
-					if c := buffer[position]; c < rune('0') || c > rune('7') {
-						goto l195
-					}
-					position++
-					goto l196
-				l195:
-					position, tokenIndex = position195, tokenIndex195
+				if !_rules[ruleAction31]() {
+					goto l285
 				}
-			l196:
 				{
-					position197, tokenIndex197 := position, tokenIndex
-					if c := buffer[position]; c < rune('0') || c > rune('7') {
-						goto l197
+					position293, tokenIndex293 := position, tokenIndex
+					if !_rules[ruleFilterValue]() {
+						goto l293
 					}
-					position++
-					goto l198
-				l197:
-					position, tokenIndex = position197, tokenIndex197
-				}
-			l198:
-				add(ruleOctalEscape, position194)
-			}
-			return true
-		l193:
-			position, tokenIndex = position193, tokenIndex193
-			return false
-		},
-		/* 20 HexEscape <- <('\\' 'x' HexDigit+)> */
-		func() bool {
-			position199, tokenIndex199 := position, tokenIndex
-			{
-				position200 := position
-				if buffer[position] != rune('\\') {
-					goto l199
-				}
-				position++
-				if buffer[position] != rune('x') {
-					goto l199
+					if !_rules[ruleAction32]() {
+						goto l293
+					}
+				l295:
+					{
+						position296, tokenIndex296 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l296
+						}
+						if !_rules[ruleFilterValue]() {
+							goto l296
+						}
+						if !_rules[ruleAction33]() {
+							goto l296
+						}
+						goto l295
+					l296:
+						position, tokenIndex = position296, tokenIndex296
+					}
+					goto l294
+				l293:
+					position, tokenIndex = position293, tokenIndex293
 				}
-				position++
-				if !_rules[ruleHexDigit]() {
-					goto l199
+			l294:
+				if !_rules[ruleRPAR]() {
+					goto l285
 				}
-			l201:
-				{
-					position202, tokenIndex202 := position, tokenIndex
-					if !_rules[ruleHexDigit]() {
-						goto l202
-					}
-					goto l201
-				l202:
-					position, tokenIndex = position202, tokenIndex202
+				if !_rules[ruleAction34]() {
+					goto l285
 				}
-				add(ruleHexEscape, position200)
+				add(ruleFilterInList, position286)
 			}
 			return true
-		l199:
-			position, tokenIndex = position199, tokenIndex199
+		l285:
+			position, tokenIndex = position285, tokenIndex285
 			return false
 		},
-		/* 21 UniversalCharacter <- <(('\\' 'u' HexQuad) / ('\\' 'U' HexQuad HexQuad))> */
+		/* 23 FilterIsNull <- <(('i' / 'I') ('s' / 'S') !IdChar _ ((('n' / 'N') ('o' / 'O') ('t' / 'T') !IdChar _ (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L')) !IdChar Action35) / (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L') !IdChar Action36)))> */
 		func() bool {
-			position203, tokenIndex203 := position, tokenIndex
+			position297, tokenIndex297 := position, tokenIndex
 			{
-				position204 := position
+				position298 := position
 				{
-					position205, tokenIndex205 := position, tokenIndex
-					if buffer[position] != rune('\\') {
-						goto l206
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l206
-					}
-					position++
-					if !_rules[ruleHexQuad]() {
-						goto l206
-					}
-					goto l205
-				l206:
-					position, tokenIndex = position205, tokenIndex205
-					if buffer[position] != rune('\\') {
-						goto l203
+					position299, tokenIndex299 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l300
 					}
 					position++
-					if buffer[position] != rune('U') {
-						goto l203
+					goto l299
+				l300:
+					position, tokenIndex = position299, tokenIndex299
+					if buffer[position] != rune('I') {
+						goto l297
 					}
 					position++
-					if !_rules[ruleHexQuad]() {
-						goto l203
-					}
-					if !_rules[ruleHexQuad]() {
-						goto l203
-					}
-				}
-			l205:
-				add(ruleUniversalCharacter, position204)
-			}
-			return true
-		l203:
-			position, tokenIndex = position203, tokenIndex203
-			return false
-		},
-		/* 22 HexQuad <- <(HexDigit HexDigit HexDigit HexDigit)> */
-		func() bool {
-			position207, tokenIndex207 := position, tokenIndex
-			{
-				position208 := position
-				if !_rules[ruleHexDigit]() {
-					goto l207
-				}
-				if !_rules[ruleHexDigit]() {
-					goto l207
-				}
-				if !_rules[ruleHexDigit]() {
-					goto l207
-				}
-				if !_rules[ruleHexDigit]() {
-					goto l207
 				}
-				add(ruleHexQuad, position208)
-			}
-			return true
-		l207:
-			position, tokenIndex = position207, tokenIndex207
-			return false
-		},
-		/* 23 HexDigit <- <([a-f] / [A-F] / [0-9])> */
-		func() bool {
-			position209, tokenIndex209 := position, tokenIndex
-			{
-				position210 := position
+			l299:
 				{
-					position211, tokenIndex211 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('f') {
-						goto l212
-					}
-					position++
-					goto l211
-				l212:
-					position, tokenIndex = position211, tokenIndex211
-					if c := buffer[position]; c < rune('A') || c > rune('F') {
-						goto l213
+					position301, tokenIndex301 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l302
 					}
 					position++
-					goto l211
-				l213:
-					position, tokenIndex = position211, tokenIndex211
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l209
+					goto l301
+				l302:
+					position, tokenIndex = position301, tokenIndex301
+					if buffer[position] != rune('S') {
+						goto l297
 					}
 					position++
 				}
-			l211:
-				add(ruleHexDigit, position210)
-			}
-			return true
-		l209:
-			position, tokenIndex = position209, tokenIndex209
-			return false
-		},
-		/* 24 Unsigned <- <[0-9]+> */
-		func() bool {
-			position214, tokenIndex214 := position, tokenIndex
-			{
-				position215 := position
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l214
-				}
-				position++
-			l216:
+			l301:
 				{
-					position217, tokenIndex217 := position, tokenIndex
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l217
+					position303, tokenIndex303 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l303
 					}
-					position++
-					goto l216
-				l217:
-					position, tokenIndex = position217, tokenIndex217
+					goto l297
+				l303:
+					position, tokenIndex = position303, tokenIndex303
 				}
-				add(ruleUnsigned, position215)
-			}
-			return true
-		l214:
-			position, tokenIndex = position214, tokenIndex214
-			return false
-		},
-		/* 25 Sign <- <('-' / '+')> */
-		func() bool {
-			position218, tokenIndex218 := position, tokenIndex
-			{
-				position219 := position
-				{
-					position220, tokenIndex220 := position, tokenIndex
-					if buffer[position] != rune('-') {
-						goto l221
-					}
-					position++
-					goto l220
-				l221:
-					position, tokenIndex = position220, tokenIndex220
-					if buffer[position] != rune('+') {
-						goto l218
-					}
-					position++
+				if !_rules[rule_]() {
+					goto l297
 				}
-			l220:
-				add(ruleSign, position219)
-			}
-			return true
-		l218:
-			position, tokenIndex = position218, tokenIndex218
-			return false
-		},
-		/* 26 Integer <- <<(Sign? Unsigned)>> */
-		func() bool {
-			position222, tokenIndex222 := position, tokenIndex
-			{
-				position223 := position
 				{
-					position224 := position
+					position304, tokenIndex304 := position, tokenIndex
 					{
-						position225, tokenIndex225 := position, tokenIndex
-						if !_rules[ruleSign]() {
-							goto l225
+						position306, tokenIndex306 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l307
 						}
-						goto l226
-					l225:
-						position, tokenIndex = position225, tokenIndex225
+						position++
+						goto l306
+					l307:
+						position, tokenIndex = position306, tokenIndex306
+						if buffer[position] != rune('N') {
+							goto l305
+						}
+						position++
 					}
-				l226:
-					if !_rules[ruleUnsigned]() {
-						goto l222
+				l306:
+					{
+						position308, tokenIndex308 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l309
+						}
+						position++
+						goto l308
+					l309:
+						position, tokenIndex = position308, tokenIndex308
+						if buffer[position] != rune('O') {
+							goto l305
+						}
+						position++
 					}
-					add(rulePegText, position224)
-				}
-				add(ruleInteger, position223)
-			}
-			return true
-		l222:
-			position, tokenIndex = position222, tokenIndex222
-			return false
-		},
-		/* 27 Float <- <(Integer ('.' Unsigned)? (('e' / 'E') Integer)?)> */
-		func() bool {
-			position227, tokenIndex227 := position, tokenIndex
-			{
-				position228 := position
-				if !_rules[ruleInteger]() {
-					goto l227
-				}
-				{
-					position229, tokenIndex229 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l229
+				l308:
+					{
+						position310, tokenIndex310 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l311
+						}
+						position++
+						goto l310
+					l311:
+						position, tokenIndex = position310, tokenIndex310
+						if buffer[position] != rune('T') {
+							goto l305
+						}
+						position++
 					}
-					position++
-					if !_rules[ruleUnsigned]() {
-						goto l229
+				l310:
+					{
+						position312, tokenIndex312 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l312
+						}
+						goto l305
+					l312:
+						position, tokenIndex = position312, tokenIndex312
+					}
+					if !_rules[rule_]() {
+						goto l305
 					}
-					goto l230
-				l229:
-					position, tokenIndex = position229, tokenIndex229
-				}
-			l230:
-				{
-					position231, tokenIndex231 := position, tokenIndex
 					{
-						position233, tokenIndex233 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l234
+						position313, tokenIndex313 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l314
 						}
 						position++
-						goto l233
-					l234:
-						position, tokenIndex = position233, tokenIndex233
-						if buffer[position] != rune('E') {
-							goto l231
+						goto l313
+					l314:
+						position, tokenIndex = position313, tokenIndex313
+						if buffer[position] != rune('N') {
+							goto l305
 						}
 						position++
 					}
-				l233:
-					if !_rules[ruleInteger]() {
-						goto l231
-					}
-					goto l232
-				l231:
-					position, tokenIndex = position231, tokenIndex231
-				}
-			l232:
-				add(ruleFloat, position228)
-			}
-			return true
-		l227:
-			position, tokenIndex = position227, tokenIndex227
-			return false
-		},
-		/* 28 Identifier <- <(!Keyword <(([a-z] / [A-Z] / '_') IdChar*)>)> */
-		func() bool {
-			position235, tokenIndex235 := position, tokenIndex
-			{
-				position236 := position
-				{
-					position237, tokenIndex237 := position, tokenIndex
-					if !_rules[ruleKeyword]() {
-						goto l237
+				l313:
+					{
+						position315, tokenIndex315 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l316
+						}
+						position++
+						goto l315
+					l316:
+						position, tokenIndex = position315, tokenIndex315
+						if buffer[position] != rune('U') {
+							goto l305
+						}
+						position++
 					}
-					goto l235
-				l237:
-					position, tokenIndex = position237, tokenIndex237
-				}
-				{
-					position238 := position
+				l315:
 					{
-						position239, tokenIndex239 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l240
+						position317, tokenIndex317 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l318
 						}
 						position++
-						goto l239
-					l240:
-						position, tokenIndex = position239, tokenIndex239
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l241
+						goto l317
+					l318:
+						position, tokenIndex = position317, tokenIndex317
+						if buffer[position] != rune('L') {
+							goto l305
 						}
 						position++
-						goto l239
-					l241:
-						position, tokenIndex = position239, tokenIndex239
-						if buffer[position] != rune('_') {
-							goto l235
+					}
+				l317:
+					{
+						position319, tokenIndex319 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l320
+						}
+						position++
+						goto l319
+					l320:
+						position, tokenIndex = position319, tokenIndex319
+						if buffer[position] != rune('L') {
+							goto l305
 						}
 						position++
 					}
-				l239:
-				l242:
+				l319:
 					{
-						position243, tokenIndex243 := position, tokenIndex
+						position321, tokenIndex321 := position, tokenIndex
 						if !_rules[ruleIdChar]() {
-							goto l243
+							goto l321
 						}
-						goto l242
-					l243:
-						position, tokenIndex = position243, tokenIndex243
+						goto l305
+					l321:
+						position, tokenIndex = position321, tokenIndex321
 					}
-					add(rulePegText, position238)
-				}
-				add(ruleIdentifier, position236)
+					if !_rules[ruleAction35]() {
+						goto l305
+					}
+					goto l304
+				l305:
+					position, tokenIndex = position304, tokenIndex304
+					{
+						position322, tokenIndex322 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l323
+						}
+						position++
+						goto l322
+					l323:
+						position, tokenIndex = position322, tokenIndex322
+						if buffer[position] != rune('N') {
+							goto l297
+						}
+						position++
+					}
+				l322:
+					{
+						position324, tokenIndex324 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l325
+						}
+						position++
+						goto l324
+					l325:
+						position, tokenIndex = position324, tokenIndex324
+						if buffer[position] != rune('U') {
+							goto l297
+						}
+						position++
+					}
+				l324:
+					{
+						position326, tokenIndex326 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l327
+						}
+						position++
+						goto l326
+					l327:
+						position, tokenIndex = position326, tokenIndex326
+						if buffer[position] != rune('L') {
+							goto l297
+						}
+						position++
+					}
+				l326:
+					{
+						position328, tokenIndex328 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l329
+						}
+						position++
+						goto l328
+					l329:
+						position, tokenIndex = position328, tokenIndex328
+						if buffer[position] != rune('L') {
+							goto l297
+						}
+						position++
+					}
+				l328:
+					{
+						position330, tokenIndex330 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l330
+						}
+						goto l297
+					l330:
+						position, tokenIndex = position330, tokenIndex330
+					}
+					if !_rules[ruleAction36]() {
+						goto l297
+					}
+				}
+			l304:
+				add(ruleFilterIsNull, position298)
+			}
+			return true
+		l297:
+			position, tokenIndex = position297, tokenIndex297
+			return false
+		},
+		/* 24 FilterBetween <- <(((('n' / 'N') ('o' / 'O') ('t' / 'T') !IdChar _ (('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N')) !IdChar Action37) / (<(('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N'))> !IdChar Action38)) _ Action39 FilterValue Action40 _ (('a' / 'A') ('n' / 'N') ('d' / 'D')) !IdChar _ FilterValue Action41 Action42)> */
+		func() bool {
+			position331, tokenIndex331 := position, tokenIndex
+			{
+				position332 := position
+				{
+					position333, tokenIndex333 := position, tokenIndex
+					{
+						position335, tokenIndex335 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l336
+						}
+						position++
+						goto l335
+					l336:
+						position, tokenIndex = position335, tokenIndex335
+						if buffer[position] != rune('N') {
+							goto l334
+						}
+						position++
+					}
+				l335:
+					{
+						position337, tokenIndex337 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l338
+						}
+						position++
+						goto l337
+					l338:
+						position, tokenIndex = position337, tokenIndex337
+						if buffer[position] != rune('O') {
+							goto l334
+						}
+						position++
+					}
+				l337:
+					{
+						position339, tokenIndex339 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l340
+						}
+						position++
+						goto l339
+					l340:
+						position, tokenIndex = position339, tokenIndex339
+						if buffer[position] != rune('T') {
+							goto l334
+						}
+						position++
+					}
+				l339:
+					{
+						position341, tokenIndex341 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l341
+						}
+						goto l334
+					l341:
+						position, tokenIndex = position341, tokenIndex341
+					}
+					if !_rules[rule_]() {
+						goto l334
+					}
+					{
+						position342, tokenIndex342 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l343
+						}
+						position++
+						goto l342
+					l343:
+						position, tokenIndex = position342, tokenIndex342
+						if buffer[position] != rune('B') {
+							goto l334
+						}
+						position++
+					}
+				l342:
+					{
+						position344, tokenIndex344 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l345
+						}
+						position++
+						goto l344
+					l345:
+						position, tokenIndex = position344, tokenIndex344
+						if buffer[position] != rune('E') {
+							goto l334
+						}
+						position++
+					}
+				l344:
+					{
+						position346, tokenIndex346 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l347
+						}
+						position++
+						goto l346
+					l347:
+						position, tokenIndex = position346, tokenIndex346
+						if buffer[position] != rune('T') {
+							goto l334
+						}
+						position++
+					}
+				l346:
+					{
+						position348, tokenIndex348 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l349
+						}
+						position++
+						goto l348
+					l349:
+						position, tokenIndex = position348, tokenIndex348
+						if buffer[position] != rune('W') {
+							goto l334
+						}
+						position++
+					}
+				l348:
+					{
+						position350, tokenIndex350 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l351
+						}
+						position++
+						goto l350
+					l351:
+						position, tokenIndex = position350, tokenIndex350
+						if buffer[position] != rune('E') {
+							goto l334
+						}
+						position++
+					}
+				l350:
+					{
+						position352, tokenIndex352 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l353
+						}
+						position++
+						goto l352
+					l353:
+						position, tokenIndex = position352, tokenIndex352
+						if buffer[position] != rune('E') {
+							goto l334
+						}
+						position++
+					}
+				l352:
+					{
+						position354, tokenIndex354 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l355
+						}
+						position++
+						goto l354
+					l355:
+						position, tokenIndex = position354, tokenIndex354
+						if buffer[position] != rune('N') {
+							goto l334
+						}
+						position++
+					}
+				l354:
+					{
+						position356, tokenIndex356 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l356
+						}
+						goto l334
+					l356:
+						position, tokenIndex = position356, tokenIndex356
+					}
+					if !_rules[ruleAction37]() {
+						goto l334
+					}
+					goto l333
+				l334:
+					position, tokenIndex = position333, tokenIndex333
+					{
+						position357 := position
+						{
+							position358, tokenIndex358 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l359
+							}
+							position++
+							goto l358
+						l359:
+							position, tokenIndex = position358, tokenIndex358
+							if buffer[position] != rune('B') {
+								goto l331
+							}
+							position++
+						}
+					l358:
+						{
+							position360, tokenIndex360 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l361
+							}
+							position++
+							goto l360
+						l361:
+							position, tokenIndex = position360, tokenIndex360
+							if buffer[position] != rune('E') {
+								goto l331
+							}
+							position++
+						}
+					l360:
+						{
+							position362, tokenIndex362 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l363
+							}
+							position++
+							goto l362
+						l363:
+							position, tokenIndex = position362, tokenIndex362
+							if buffer[position] != rune('T') {
+								goto l331
+							}
+							position++
+						}
+					l362:
+						{
+							position364, tokenIndex364 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l365
+							}
+							position++
+							goto l364
+						l365:
+							position, tokenIndex = position364, tokenIndex364
+							if buffer[position] != rune('W') {
+								goto l331
+							}
+							position++
+						}
+					l364:
+						{
+							position366, tokenIndex366 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l367
+							}
+							position++
+							goto l366
+						l367:
+							position, tokenIndex = position366, tokenIndex366
+							if buffer[position] != rune('E') {
+								goto l331
+							}
+							position++
+						}
+					l366:
+						{
+							position368, tokenIndex368 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l369
+							}
+							position++
+							goto l368
+						l369:
+							position, tokenIndex = position368, tokenIndex368
+							if buffer[position] != rune('E') {
+								goto l331
+							}
+							position++
+						}
+					l368:
+						{
+							position370, tokenIndex370 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l371
+							}
+							position++
+							goto l370
+						l371:
+							position, tokenIndex = position370, tokenIndex370
+							if buffer[position] != rune('N') {
+								goto l331
+							}
+							position++
+						}
+					l370:
+						add(rulePegText, position357)
+					}
+					{
+						position372, tokenIndex372 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l372
+						}
+						goto l331
+					l372:
+						position, tokenIndex = position372, tokenIndex372
+					}
+					if !_rules[ruleAction38]() {
+						goto l331
+					}
+				}
+			l333:
+				if !_rules[rule_]() {
+					goto l331
+				}
+				if !_rules[ruleAction39]() {
+					goto l331
+				}
+				if !_rules[ruleFilterValue]() {
+					goto l331
+				}
+				if !_rules[ruleAction40]() {
+					goto l331
+				}
+				if !_rules[rule_]() {
+					goto l331
+				}
+				{
+					position373, tokenIndex373 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l374
+					}
+					position++
+					goto l373
+				l374:
+					position, tokenIndex = position373, tokenIndex373
+					if buffer[position] != rune('A') {
+						goto l331
+					}
+					position++
+				}
+			l373:
+				{
+					position375, tokenIndex375 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l376
+					}
+					position++
+					goto l375
+				l376:
+					position, tokenIndex = position375, tokenIndex375
+					if buffer[position] != rune('N') {
+						goto l331
+					}
+					position++
+				}
+			l375:
+				{
+					position377, tokenIndex377 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l378
+					}
+					position++
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
+					if buffer[position] != rune('D') {
+						goto l331
+					}
+					position++
+				}
+			l377:
+				{
+					position379, tokenIndex379 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l379
+					}
+					goto l331
+				l379:
+					position, tokenIndex = position379, tokenIndex379
+				}
+				if !_rules[rule_]() {
+					goto l331
+				}
+				if !_rules[ruleFilterValue]() {
+					goto l331
+				}
+				if !_rules[ruleAction41]() {
+					goto l331
+				}
+				if !_rules[ruleAction42]() {
+					goto l331
+				}
+				add(ruleFilterBetween, position332)
+			}
+			return true
+		l331:
+			position, tokenIndex = position331, tokenIndex331
+			return false
+		},
+		/* 25 SampleExpr <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') LPAR <(Unsigned ('.' Unsigned)?)> Action43 (COMMA <Identifier> Action44)? RPAR)> */
+		func() bool {
+			position380, tokenIndex380 := position, tokenIndex
+			{
+				position381 := position
+				{
+					position382, tokenIndex382 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l383
+					}
+					position++
+					goto l382
+				l383:
+					position, tokenIndex = position382, tokenIndex382
+					if buffer[position] != rune('S') {
+						goto l380
+					}
+					position++
+				}
+			l382:
+				{
+					position384, tokenIndex384 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l385
+					}
+					position++
+					goto l384
+				l385:
+					position, tokenIndex = position384, tokenIndex384
+					if buffer[position] != rune('A') {
+						goto l380
+					}
+					position++
+				}
+			l384:
+				{
+					position386, tokenIndex386 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l387
+					}
+					position++
+					goto l386
+				l387:
+					position, tokenIndex = position386, tokenIndex386
+					if buffer[position] != rune('M') {
+						goto l380
+					}
+					position++
+				}
+			l386:
+				{
+					position388, tokenIndex388 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l389
+					}
+					position++
+					goto l388
+				l389:
+					position, tokenIndex = position388, tokenIndex388
+					if buffer[position] != rune('P') {
+						goto l380
+					}
+					position++
+				}
+			l388:
+				{
+					position390, tokenIndex390 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l391
+					}
+					position++
+					goto l390
+				l391:
+					position, tokenIndex = position390, tokenIndex390
+					if buffer[position] != rune('L') {
+						goto l380
+					}
+					position++
+				}
+			l390:
+				{
+					position392, tokenIndex392 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l393
+					}
+					position++
+					goto l392
+				l393:
+					position, tokenIndex = position392, tokenIndex392
+					if buffer[position] != rune('E') {
+						goto l380
+					}
+					position++
+				}
+			l392:
+				if !_rules[ruleLPAR]() {
+					goto l380
+				}
+				{
+					position394 := position
+					if !_rules[ruleUnsigned]() {
+						goto l380
+					}
+					{
+						position395, tokenIndex395 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l395
+						}
+						position++
+						if !_rules[ruleUnsigned]() {
+							goto l395
+						}
+						goto l396
+					l395:
+						position, tokenIndex = position395, tokenIndex395
+					}
+				l396:
+					add(rulePegText, position394)
+				}
+				if !_rules[ruleAction43]() {
+					goto l380
+				}
+				{
+					position397, tokenIndex397 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l397
+					}
+					{
+						position399 := position
+						if !_rules[ruleIdentifier]() {
+							goto l397
+						}
+						add(rulePegText, position399)
+					}
+					if !_rules[ruleAction44]() {
+						goto l397
+					}
+					goto l398
+				l397:
+					position, tokenIndex = position397, tokenIndex397
+				}
+			l398:
+				if !_rules[ruleRPAR]() {
+					goto l380
+				}
+				add(ruleSampleExpr, position381)
+			}
+			return true
+		l380:
+			position, tokenIndex = position380, tokenIndex380
+			return false
+		},
+		/* 26 Quantifier <- <((('a' / 'A') ('n' / 'N') ('y' / 'Y')) / (('a' / 'A') ('l' / 'L') ('l' / 'L')))> */
+		func() bool {
+			position400, tokenIndex400 := position, tokenIndex
+			{
+				position401 := position
+				{
+					position402, tokenIndex402 := position, tokenIndex
+					{
+						position404, tokenIndex404 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l405
+						}
+						position++
+						goto l404
+					l405:
+						position, tokenIndex = position404, tokenIndex404
+						if buffer[position] != rune('A') {
+							goto l403
+						}
+						position++
+					}
+				l404:
+					{
+						position406, tokenIndex406 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l407
+						}
+						position++
+						goto l406
+					l407:
+						position, tokenIndex = position406, tokenIndex406
+						if buffer[position] != rune('N') {
+							goto l403
+						}
+						position++
+					}
+				l406:
+					{
+						position408, tokenIndex408 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l409
+						}
+						position++
+						goto l408
+					l409:
+						position, tokenIndex = position408, tokenIndex408
+						if buffer[position] != rune('Y') {
+							goto l403
+						}
+						position++
+					}
+				l408:
+					goto l402
+				l403:
+					position, tokenIndex = position402, tokenIndex402
+					{
+						position410, tokenIndex410 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l411
+						}
+						position++
+						goto l410
+					l411:
+						position, tokenIndex = position410, tokenIndex410
+						if buffer[position] != rune('A') {
+							goto l400
+						}
+						position++
+					}
+				l410:
+					{
+						position412, tokenIndex412 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l413
+						}
+						position++
+						goto l412
+					l413:
+						position, tokenIndex = position412, tokenIndex412
+						if buffer[position] != rune('L') {
+							goto l400
+						}
+						position++
+					}
+				l412:
+					{
+						position414, tokenIndex414 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l415
+						}
+						position++
+						goto l414
+					l415:
+						position, tokenIndex = position414, tokenIndex414
+						if buffer[position] != rune('L') {
+							goto l400
+						}
+						position++
+					}
+				l414:
+				}
+			l402:
+				add(ruleQuantifier, position401)
+			}
+			return true
+		l400:
+			position, tokenIndex = position400, tokenIndex400
+			return false
+		},
+		/* 27 OPERATOR <- <('=' / ('!' '=') / ('<' '=') / ('>' '=') / '<' / '>' / (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) / (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')) / (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')) / (('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) / (('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) / (('i' / 'I') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) / (('i' / 'I') ('e' / 'E') ('n' / 'N') ('d' / 'D') ('s' / 'S') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) / (('i' / 'I') ('n' / 'N') '_' ('c' / 'C') ('i' / 'I') ('d' / 'D') ('r' / 'R')))> */
+		func() bool {
+			position416, tokenIndex416 := position, tokenIndex
+			{
+				position417 := position
+				{
+					position418, tokenIndex418 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l419
+					}
+					position++
+					goto l418
+				l419:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('!') {
+						goto l420
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l420
+					}
+					position++
+					goto l418
+				l420:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('<') {
+						goto l421
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l421
+					}
+					position++
+					goto l418
+				l421:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('>') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l422
+					}
+					position++
+					goto l418
+				l422:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('<') {
+						goto l423
+					}
+					position++
+					goto l418
+				l423:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('>') {
+						goto l424
+					}
+					position++
+					goto l418
+				l424:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position426, tokenIndex426 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l427
+						}
+						position++
+						goto l426
+					l427:
+						position, tokenIndex = position426, tokenIndex426
+						if buffer[position] != rune('M') {
+							goto l425
+						}
+						position++
+					}
+				l426:
+					{
+						position428, tokenIndex428 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l429
+						}
+						position++
+						goto l428
+					l429:
+						position, tokenIndex = position428, tokenIndex428
+						if buffer[position] != rune('A') {
+							goto l425
+						}
+						position++
+					}
+				l428:
+					{
+						position430, tokenIndex430 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l431
+						}
+						position++
+						goto l430
+					l431:
+						position, tokenIndex = position430, tokenIndex430
+						if buffer[position] != rune('T') {
+							goto l425
+						}
+						position++
+					}
+				l430:
+					{
+						position432, tokenIndex432 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l433
+						}
+						position++
+						goto l432
+					l433:
+						position, tokenIndex = position432, tokenIndex432
+						if buffer[position] != rune('C') {
+							goto l425
+						}
+						position++
+					}
+				l432:
+					{
+						position434, tokenIndex434 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l435
+						}
+						position++
+						goto l434
+					l435:
+						position, tokenIndex = position434, tokenIndex434
+						if buffer[position] != rune('H') {
+							goto l425
+						}
+						position++
+					}
+				l434:
+					{
+						position436, tokenIndex436 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l437
+						}
+						position++
+						goto l436
+					l437:
+						position, tokenIndex = position436, tokenIndex436
+						if buffer[position] != rune('E') {
+							goto l425
+						}
+						position++
+					}
+				l436:
+					{
+						position438, tokenIndex438 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l439
+						}
+						position++
+						goto l438
+					l439:
+						position, tokenIndex = position438, tokenIndex438
+						if buffer[position] != rune('S') {
+							goto l425
+						}
+						position++
+					}
+				l438:
+					goto l418
+				l425:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position441, tokenIndex441 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l442
+						}
+						position++
+						goto l441
+					l442:
+						position, tokenIndex = position441, tokenIndex441
+						if buffer[position] != rune('L') {
+							goto l440
+						}
+						position++
+					}
+				l441:
+					{
+						position443, tokenIndex443 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l444
+						}
+						position++
+						goto l443
+					l444:
+						position, tokenIndex = position443, tokenIndex443
+						if buffer[position] != rune('I') {
+							goto l440
+						}
+						position++
+					}
+				l443:
+					{
+						position445, tokenIndex445 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l446
+						}
+						position++
+						goto l445
+					l446:
+						position, tokenIndex = position445, tokenIndex445
+						if buffer[position] != rune('K') {
+							goto l440
+						}
+						position++
+					}
+				l445:
+					{
+						position447, tokenIndex447 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l448
+						}
+						position++
+						goto l447
+					l448:
+						position, tokenIndex = position447, tokenIndex447
+						if buffer[position] != rune('E') {
+							goto l440
+						}
+						position++
+					}
+				l447:
+					goto l418
+				l440:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position450, tokenIndex450 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l451
+						}
+						position++
+						goto l450
+					l451:
+						position, tokenIndex = position450, tokenIndex450
+						if buffer[position] != rune('I') {
+							goto l449
+						}
+						position++
+					}
+				l450:
+					{
+						position452, tokenIndex452 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l453
+						}
+						position++
+						goto l452
+					l453:
+						position, tokenIndex = position452, tokenIndex452
+						if buffer[position] != rune('L') {
+							goto l449
+						}
+						position++
+					}
+				l452:
+					{
+						position454, tokenIndex454 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l455
+						}
+						position++
+						goto l454
+					l455:
+						position, tokenIndex = position454, tokenIndex454
+						if buffer[position] != rune('I') {
+							goto l449
+						}
+						position++
+					}
+				l454:
+					{
+						position456, tokenIndex456 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l457
+						}
+						position++
+						goto l456
+					l457:
+						position, tokenIndex = position456, tokenIndex456
+						if buffer[position] != rune('K') {
+							goto l449
+						}
+						position++
+					}
+				l456:
+					{
+						position458, tokenIndex458 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l459
+						}
+						position++
+						goto l458
+					l459:
+						position, tokenIndex = position458, tokenIndex458
+						if buffer[position] != rune('E') {
+							goto l449
+						}
+						position++
+					}
+				l458:
+					goto l418
+				l449:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position461, tokenIndex461 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l462
+						}
+						position++
+						goto l461
+					l462:
+						position, tokenIndex = position461, tokenIndex461
+						if buffer[position] != rune('S') {
+							goto l460
+						}
+						position++
+					}
+				l461:
+					{
+						position463, tokenIndex463 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l464
+						}
+						position++
+						goto l463
+					l464:
+						position, tokenIndex = position463, tokenIndex463
+						if buffer[position] != rune('T') {
+							goto l460
+						}
+						position++
+					}
+				l463:
+					{
+						position465, tokenIndex465 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l466
+						}
+						position++
+						goto l465
+					l466:
+						position, tokenIndex = position465, tokenIndex465
+						if buffer[position] != rune('A') {
+							goto l460
+						}
+						position++
+					}
+				l465:
+					{
+						position467, tokenIndex467 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l468
+						}
+						position++
+						goto l467
+					l468:
+						position, tokenIndex = position467, tokenIndex467
+						if buffer[position] != rune('R') {
+							goto l460
+						}
+						position++
+					}
+				l467:
+					{
+						position469, tokenIndex469 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l470
+						}
+						position++
+						goto l469
+					l470:
+						position, tokenIndex = position469, tokenIndex469
+						if buffer[position] != rune('T') {
+							goto l460
+						}
+						position++
+					}
+				l469:
+					{
+						position471, tokenIndex471 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l472
+						}
+						position++
+						goto l471
+					l472:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('S') {
+							goto l460
+						}
+						position++
+					}
+				l471:
+					if buffer[position] != rune('_') {
+						goto l460
+					}
+					position++
+					{
+						position473, tokenIndex473 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l474
+						}
+						position++
+						goto l473
+					l474:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('W') {
+							goto l460
+						}
+						position++
+					}
+				l473:
+					{
+						position475, tokenIndex475 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l476
+						}
+						position++
+						goto l475
+					l476:
+						position, tokenIndex = position475, tokenIndex475
+						if buffer[position] != rune('I') {
+							goto l460
+						}
+						position++
+					}
+				l475:
+					{
+						position477, tokenIndex477 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l478
+						}
+						position++
+						goto l477
+					l478:
+						position, tokenIndex = position477, tokenIndex477
+						if buffer[position] != rune('T') {
+							goto l460
+						}
+						position++
+					}
+				l477:
+					{
+						position479, tokenIndex479 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l480
+						}
+						position++
+						goto l479
+					l480:
+						position, tokenIndex = position479, tokenIndex479
+						if buffer[position] != rune('H') {
+							goto l460
+						}
+						position++
+					}
+				l479:
+					goto l418
+				l460:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position482, tokenIndex482 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l483
+						}
+						position++
+						goto l482
+					l483:
+						position, tokenIndex = position482, tokenIndex482
+						if buffer[position] != rune('E') {
+							goto l481
+						}
+						position++
+					}
+				l482:
+					{
+						position484, tokenIndex484 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l485
+						}
+						position++
+						goto l484
+					l485:
+						position, tokenIndex = position484, tokenIndex484
+						if buffer[position] != rune('N') {
+							goto l481
+						}
+						position++
+					}
+				l484:
+					{
+						position486, tokenIndex486 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l487
+						}
+						position++
+						goto l486
+					l487:
+						position, tokenIndex = position486, tokenIndex486
+						if buffer[position] != rune('D') {
+							goto l481
+						}
+						position++
+					}
+				l486:
+					{
+						position488, tokenIndex488 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l489
+						}
+						position++
+						goto l488
+					l489:
+						position, tokenIndex = position488, tokenIndex488
+						if buffer[position] != rune('S') {
+							goto l481
+						}
+						position++
+					}
+				l488:
+					if buffer[position] != rune('_') {
+						goto l481
+					}
+					position++
+					{
+						position490, tokenIndex490 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l491
+						}
+						position++
+						goto l490
+					l491:
+						position, tokenIndex = position490, tokenIndex490
+						if buffer[position] != rune('W') {
+							goto l481
+						}
+						position++
+					}
+				l490:
+					{
+						position492, tokenIndex492 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l493
+						}
+						position++
+						goto l492
+					l493:
+						position, tokenIndex = position492, tokenIndex492
+						if buffer[position] != rune('I') {
+							goto l481
+						}
+						position++
+					}
+				l492:
+					{
+						position494, tokenIndex494 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l495
+						}
+						position++
+						goto l494
+					l495:
+						position, tokenIndex = position494, tokenIndex494
+						if buffer[position] != rune('T') {
+							goto l481
+						}
+						position++
+					}
+				l494:
+					{
+						position496, tokenIndex496 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l497
+						}
+						position++
+						goto l496
+					l497:
+						position, tokenIndex = position496, tokenIndex496
+						if buffer[position] != rune('H') {
+							goto l481
+						}
+						position++
+					}
+				l496:
+					goto l418
+				l481:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position499, tokenIndex499 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l500
+						}
+						position++
+						goto l499
+					l500:
+						position, tokenIndex = position499, tokenIndex499
+						if buffer[position] != rune('I') {
+							goto l498
+						}
+						position++
+					}
+				l499:
+					{
+						position501, tokenIndex501 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l502
+						}
+						position++
+						goto l501
+					l502:
+						position, tokenIndex = position501, tokenIndex501
+						if buffer[position] != rune('S') {
+							goto l498
+						}
+						position++
+					}
+				l501:
+					{
+						position503, tokenIndex503 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l504
+						}
+						position++
+						goto l503
+					l504:
+						position, tokenIndex = position503, tokenIndex503
+						if buffer[position] != rune('T') {
+							goto l498
+						}
+						position++
+					}
+				l503:
+					{
+						position505, tokenIndex505 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l506
+						}
+						position++
+						goto l505
+					l506:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('A') {
+							goto l498
+						}
+						position++
+					}
+				l505:
+					{
+						position507, tokenIndex507 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l508
+						}
+						position++
+						goto l507
+					l508:
+						position, tokenIndex = position507, tokenIndex507
+						if buffer[position] != rune('R') {
+							goto l498
+						}
+						position++
+					}
+				l507:
+					{
+						position509, tokenIndex509 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l510
+						}
+						position++
+						goto l509
+					l510:
+						position, tokenIndex = position509, tokenIndex509
+						if buffer[position] != rune('T') {
+							goto l498
+						}
+						position++
+					}
+				l509:
+					{
+						position511, tokenIndex511 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l512
+						}
+						position++
+						goto l511
+					l512:
+						position, tokenIndex = position511, tokenIndex511
+						if buffer[position] != rune('S') {
+							goto l498
+						}
+						position++
+					}
+				l511:
+					if buffer[position] != rune('_') {
+						goto l498
+					}
+					position++
+					{
+						position513, tokenIndex513 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l514
+						}
+						position++
+						goto l513
+					l514:
+						position, tokenIndex = position513, tokenIndex513
+						if buffer[position] != rune('W') {
+							goto l498
+						}
+						position++
+					}
+				l513:
+					{
+						position515, tokenIndex515 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l516
+						}
+						position++
+						goto l515
+					l516:
+						position, tokenIndex = position515, tokenIndex515
+						if buffer[position] != rune('I') {
+							goto l498
+						}
+						position++
+					}
+				l515:
+					{
+						position517, tokenIndex517 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l518
+						}
+						position++
+						goto l517
+					l518:
+						position, tokenIndex = position517, tokenIndex517
+						if buffer[position] != rune('T') {
+							goto l498
+						}
+						position++
+					}
+				l517:
+					{
+						position519, tokenIndex519 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l520
+						}
+						position++
+						goto l519
+					l520:
+						position, tokenIndex = position519, tokenIndex519
+						if buffer[position] != rune('H') {
+							goto l498
+						}
+						position++
+					}
+				l519:
+					goto l418
+				l498:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position522, tokenIndex522 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l523
+						}
+						position++
+						goto l522
+					l523:
+						position, tokenIndex = position522, tokenIndex522
+						if buffer[position] != rune('I') {
+							goto l521
+						}
+						position++
+					}
+				l522:
+					{
+						position524, tokenIndex524 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l525
+						}
+						position++
+						goto l524
+					l525:
+						position, tokenIndex = position524, tokenIndex524
+						if buffer[position] != rune('E') {
+							goto l521
+						}
+						position++
+					}
+				l524:
+					{
+						position526, tokenIndex526 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l527
+						}
+						position++
+						goto l526
+					l527:
+						position, tokenIndex = position526, tokenIndex526
+						if buffer[position] != rune('N') {
+							goto l521
+						}
+						position++
+					}
+				l526:
+					{
+						position528, tokenIndex528 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l529
+						}
+						position++
+						goto l528
+					l529:
+						position, tokenIndex = position528, tokenIndex528
+						if buffer[position] != rune('D') {
+							goto l521
+						}
+						position++
+					}
+				l528:
+					{
+						position530, tokenIndex530 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l531
+						}
+						position++
+						goto l530
+					l531:
+						position, tokenIndex = position530, tokenIndex530
+						if buffer[position] != rune('S') {
+							goto l521
+						}
+						position++
+					}
+				l530:
+					if buffer[position] != rune('_') {
+						goto l521
+					}
+					position++
+					{
+						position532, tokenIndex532 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l533
+						}
+						position++
+						goto l532
+					l533:
+						position, tokenIndex = position532, tokenIndex532
+						if buffer[position] != rune('W') {
+							goto l521
+						}
+						position++
+					}
+				l532:
+					{
+						position534, tokenIndex534 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l535
+						}
+						position++
+						goto l534
+					l535:
+						position, tokenIndex = position534, tokenIndex534
+						if buffer[position] != rune('I') {
+							goto l521
+						}
+						position++
+					}
+				l534:
+					{
+						position536, tokenIndex536 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l537
+						}
+						position++
+						goto l536
+					l537:
+						position, tokenIndex = position536, tokenIndex536
+						if buffer[position] != rune('T') {
+							goto l521
+						}
+						position++
+					}
+				l536:
+					{
+						position538, tokenIndex538 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l539
+						}
+						position++
+						goto l538
+					l539:
+						position, tokenIndex = position538, tokenIndex538
+						if buffer[position] != rune('H') {
+							goto l521
+						}
+						position++
+					}
+				l538:
+					goto l418
+				l521:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						position540, tokenIndex540 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l541
+						}
+						position++
+						goto l540
+					l541:
+						position, tokenIndex = position540, tokenIndex540
+						if buffer[position] != rune('I') {
+							goto l416
+						}
+						position++
+					}
+				l540:
+					{
+						position542, tokenIndex542 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l543
+						}
+						position++
+						goto l542
+					l543:
+						position, tokenIndex = position542, tokenIndex542
+						if buffer[position] != rune('N') {
+							goto l416
+						}
+						position++
+					}
+				l542:
+					if buffer[position] != rune('_') {
+						goto l416
+					}
+					position++
+					{
+						position544, tokenIndex544 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l545
+						}
+						position++
+						goto l544
+					l545:
+						position, tokenIndex = position544, tokenIndex544
+						if buffer[position] != rune('C') {
+							goto l416
+						}
+						position++
+					}
+				l544:
+					{
+						position546, tokenIndex546 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l547
+						}
+						position++
+						goto l546
+					l547:
+						position, tokenIndex = position546, tokenIndex546
+						if buffer[position] != rune('I') {
+							goto l416
+						}
+						position++
+					}
+				l546:
+					{
+						position548, tokenIndex548 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l549
+						}
+						position++
+						goto l548
+					l549:
+						position, tokenIndex = position548, tokenIndex548
+						if buffer[position] != rune('D') {
+							goto l416
+						}
+						position++
+					}
+				l548:
+					{
+						position550, tokenIndex550 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l551
+						}
+						position++
+						goto l550
+					l551:
+						position, tokenIndex = position550, tokenIndex550
+						if buffer[position] != rune('R') {
+							goto l416
+						}
+						position++
+					}
+				l550:
+				}
+			l418:
+				add(ruleOPERATOR, position417)
+			}
+			return true
+		l416:
+			position, tokenIndex = position416, tokenIndex416
+			return false
+		},
+		/* 28 FilterKey <- <((<Identifier> Action45 LPAR <Identifier> Action46 (COMMA <String> Action47)* RPAR) / (<Identifier> Action48 LPAR '*' RPAR) / (<Identifier> Action49))> */
+		func() bool {
+			position552, tokenIndex552 := position, tokenIndex
+			{
+				position553 := position
+				{
+					position554, tokenIndex554 := position, tokenIndex
+					{
+						position556 := position
+						if !_rules[ruleIdentifier]() {
+							goto l555
+						}
+						add(rulePegText, position556)
+					}
+					if !_rules[ruleAction45]() {
+						goto l555
+					}
+					if !_rules[ruleLPAR]() {
+						goto l555
+					}
+					{
+						position557 := position
+						if !_rules[ruleIdentifier]() {
+							goto l555
+						}
+						add(rulePegText, position557)
+					}
+					if !_rules[ruleAction46]() {
+						goto l555
+					}
+				l558:
+					{
+						position559, tokenIndex559 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l559
+						}
+						{
+							position560 := position
+							if !_rules[ruleString]() {
+								goto l559
+							}
+							add(rulePegText, position560)
+						}
+						if !_rules[ruleAction47]() {
+							goto l559
+						}
+						goto l558
+					l559:
+						position, tokenIndex = position559, tokenIndex559
+					}
+					if !_rules[ruleRPAR]() {
+						goto l555
+					}
+					goto l554
+				l555:
+					position, tokenIndex = position554, tokenIndex554
+					{
+						position562 := position
+						if !_rules[ruleIdentifier]() {
+							goto l561
+						}
+						add(rulePegText, position562)
+					}
+					if !_rules[ruleAction48]() {
+						goto l561
+					}
+					if !_rules[ruleLPAR]() {
+						goto l561
+					}
+					if buffer[position] != rune('*') {
+						goto l561
+					}
+					position++
+					if !_rules[ruleRPAR]() {
+						goto l561
+					}
+					goto l554
+				l561:
+					position, tokenIndex = position554, tokenIndex554
+					{
+						position563 := position
+						if !_rules[ruleIdentifier]() {
+							goto l552
+						}
+						add(rulePegText, position563)
+					}
+					if !_rules[ruleAction49]() {
+						goto l552
+					}
+				}
+			l554:
+				add(ruleFilterKey, position553)
+			}
+			return true
+		l552:
+			position, tokenIndex = position552, tokenIndex552
+			return false
+		},
+		/* 29 FilterOperator <- <(<OPERATOR> Action50)> */
+		func() bool {
+			position564, tokenIndex564 := position, tokenIndex
+			{
+				position565 := position
+				{
+					position566 := position
+					if !_rules[ruleOPERATOR]() {
+						goto l564
+					}
+					add(rulePegText, position566)
+				}
+				if !_rules[ruleAction50]() {
+					goto l564
+				}
+				add(ruleFilterOperator, position565)
+			}
+			return true
+		l564:
+			position, tokenIndex = position564, tokenIndex564
+			return false
+		},
+		/* 30 FilterValues <- <(FilterValue (_ '|' _ Action51 FilterValue Action52)*)> */
+		func() bool {
+			position567, tokenIndex567 := position, tokenIndex
+			{
+				position568 := position
+				if !_rules[ruleFilterValue]() {
+					goto l567
+				}
+			l569:
+				{
+					position570, tokenIndex570 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l570
+					}
+					if buffer[position] != rune('|') {
+						goto l570
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l570
+					}
+					if !_rules[ruleAction51]() {
+						goto l570
+					}
+					if !_rules[ruleFilterValue]() {
+						goto l570
+					}
+					if !_rules[ruleAction52]() {
+						goto l570
+					}
+					goto l569
+				l570:
+					position, tokenIndex = position570, tokenIndex570
+				}
+				add(ruleFilterValues, position568)
+			}
+			return true
+		l567:
+			position, tokenIndex = position567, tokenIndex567
+			return false
+		},
+		/* 31 FilterValue <- <((<Float> Action53) / (<Integer> Action54) / (<String> Action55) / (':' <Identifier> Action56) / (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L') !IdChar Action57) / (<((('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E')) / (('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E')))> !IdChar Action58) / NowValue / CastValue / (<Identifier> Action59 (_ <ArithmeticOperator> Action60 _ <Identifier> Action61)?))> */
+		func() bool {
+			position571, tokenIndex571 := position, tokenIndex
+			{
+				position572 := position
+				{
+					position573, tokenIndex573 := position, tokenIndex
+					{
+						position575 := position
+						if !_rules[ruleFloat]() {
+							goto l574
+						}
+						add(rulePegText, position575)
+					}
+					if !_rules[ruleAction53]() {
+						goto l574
+					}
+					goto l573
+				l574:
+					position, tokenIndex = position573, tokenIndex573
+					{
+						position577 := position
+						if !_rules[ruleInteger]() {
+							goto l576
+						}
+						add(rulePegText, position577)
+					}
+					if !_rules[ruleAction54]() {
+						goto l576
+					}
+					goto l573
+				l576:
+					position, tokenIndex = position573, tokenIndex573
+					{
+						position579 := position
+						if !_rules[ruleString]() {
+							goto l578
+						}
+						add(rulePegText, position579)
+					}
+					if !_rules[ruleAction55]() {
+						goto l578
+					}
+					goto l573
+				l578:
+					position, tokenIndex = position573, tokenIndex573
+					if buffer[position] != rune(':') {
+						goto l580
+					}
+					position++
+					{
+						position581 := position
+						if !_rules[ruleIdentifier]() {
+							goto l580
+						}
+						add(rulePegText, position581)
+					}
+					if !_rules[ruleAction56]() {
+						goto l580
+					}
+					goto l573
+				l580:
+					position, tokenIndex = position573, tokenIndex573
+					{
+						position583, tokenIndex583 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l584
+						}
+						position++
+						goto l583
+					l584:
+						position, tokenIndex = position583, tokenIndex583
+						if buffer[position] != rune('N') {
+							goto l582
+						}
+						position++
+					}
+				l583:
+					{
+						position585, tokenIndex585 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l586
+						}
+						position++
+						goto l585
+					l586:
+						position, tokenIndex = position585, tokenIndex585
+						if buffer[position] != rune('U') {
+							goto l582
+						}
+						position++
+					}
+				l585:
+					{
+						position587, tokenIndex587 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l588
+						}
+						position++
+						goto l587
+					l588:
+						position, tokenIndex = position587, tokenIndex587
+						if buffer[position] != rune('L') {
+							goto l582
+						}
+						position++
+					}
+				l587:
+					{
+						position589, tokenIndex589 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l590
+						}
+						position++
+						goto l589
+					l590:
+						position, tokenIndex = position589, tokenIndex589
+						if buffer[position] != rune('L') {
+							goto l582
+						}
+						position++
+					}
+				l589:
+					{
+						position591, tokenIndex591 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l591
+						}
+						goto l582
+					l591:
+						position, tokenIndex = position591, tokenIndex591
+					}
+					if !_rules[ruleAction57]() {
+						goto l582
+					}
+					goto l573
+				l582:
+					position, tokenIndex = position573, tokenIndex573
+					{
+						position593 := position
+						{
+							position594, tokenIndex594 := position, tokenIndex
+							{
+								position596, tokenIndex596 := position, tokenIndex
+								if buffer[position] != rune('t') {
+									goto l597
+								}
+								position++
+								goto l596
+							l597:
+								position, tokenIndex = position596, tokenIndex596
+								if buffer[position] != rune('T') {
+									goto l595
+								}
+								position++
+							}
+						l596:
+							{
+								position598, tokenIndex598 := position, tokenIndex
+								if buffer[position] != rune('r') {
+									goto l599
+								}
+								position++
+								goto l598
+							l599:
+								position, tokenIndex = position598, tokenIndex598
+								if buffer[position] != rune('R') {
+									goto l595
+								}
+								position++
+							}
+						l598:
+							{
+								position600, tokenIndex600 := position, tokenIndex
+								if buffer[position] != rune('u') {
+									goto l601
+								}
+								position++
+								goto l600
+							l601:
+								position, tokenIndex = position600, tokenIndex600
+								if buffer[position] != rune('U') {
+									goto l595
+								}
+								position++
+							}
+						l600:
+							{
+								position602, tokenIndex602 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l603
+								}
+								position++
+								goto l602
+							l603:
+								position, tokenIndex = position602, tokenIndex602
+								if buffer[position] != rune('E') {
+									goto l595
+								}
+								position++
+							}
+						l602:
+							goto l594
+						l595:
+							position, tokenIndex = position594, tokenIndex594
+							{
+								position604, tokenIndex604 := position, tokenIndex
+								if buffer[position] != rune('f') {
+									goto l605
+								}
+								position++
+								goto l604
+							l605:
+								position, tokenIndex = position604, tokenIndex604
+								if buffer[position] != rune('F') {
+									goto l592
+								}
+								position++
+							}
+						l604:
+							{
+								position606, tokenIndex606 := position, tokenIndex
+								if buffer[position] != rune('a') {
+									goto l607
+								}
+								position++
+								goto l606
+							l607:
+								position, tokenIndex = position606, tokenIndex606
+								if buffer[position] != rune('A') {
+									goto l592
+								}
+								position++
+							}
+						l606:
+							{
+								position608, tokenIndex608 := position, tokenIndex
+								if buffer[position] != rune('l') {
+									goto l609
+								}
+								position++
+								goto l608
+							l609:
+								position, tokenIndex = position608, tokenIndex608
+								if buffer[position] != rune('L') {
+									goto l592
+								}
+								position++
+							}
+						l608:
+							{
+								position610, tokenIndex610 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l611
+								}
+								position++
+								goto l610
+							l611:
+								position, tokenIndex = position610, tokenIndex610
+								if buffer[position] != rune('S') {
+									goto l592
+								}
+								position++
+							}
+						l610:
+							{
+								position612, tokenIndex612 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l613
+								}
+								position++
+								goto l612
+							l613:
+								position, tokenIndex = position612, tokenIndex612
+								if buffer[position] != rune('E') {
+									goto l592
+								}
+								position++
+							}
+						l612:
+						}
+					l594:
+						add(rulePegText, position593)
+					}
+					{
+						position614, tokenIndex614 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l614
+						}
+						goto l592
+					l614:
+						position, tokenIndex = position614, tokenIndex614
+					}
+					if !_rules[ruleAction58]() {
+						goto l592
+					}
+					goto l573
+				l592:
+					position, tokenIndex = position573, tokenIndex573
+					if !_rules[ruleNowValue]() {
+						goto l615
+					}
+					goto l573
+				l615:
+					position, tokenIndex = position573, tokenIndex573
+					if !_rules[ruleCastValue]() {
+						goto l616
+					}
+					goto l573
+				l616:
+					position, tokenIndex = position573, tokenIndex573
+					{
+						position617 := position
+						if !_rules[ruleIdentifier]() {
+							goto l571
+						}
+						add(rulePegText, position617)
+					}
+					if !_rules[ruleAction59]() {
+						goto l571
+					}
+					{
+						position618, tokenIndex618 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l618
+						}
+						{
+							position620 := position
+							if !_rules[ruleArithmeticOperator]() {
+								goto l618
+							}
+							add(rulePegText, position620)
+						}
+						if !_rules[ruleAction60]() {
+							goto l618
+						}
+						if !_rules[rule_]() {
+							goto l618
+						}
+						{
+							position621 := position
+							if !_rules[ruleIdentifier]() {
+								goto l618
+							}
+							add(rulePegText, position621)
+						}
+						if !_rules[ruleAction61]() {
+							goto l618
+						}
+						goto l619
+					l618:
+						position, tokenIndex = position618, tokenIndex618
+					}
+				l619:
+				}
+			l573:
+				add(ruleFilterValue, position572)
+			}
+			return true
+		l571:
+			position, tokenIndex = position571, tokenIndex571
+			return false
+		},
+		/* 32 ArithmeticOperator <- <('+' / '-' / '*' / '/')> */
+		func() bool {
+			position622, tokenIndex622 := position, tokenIndex
+			{
+				position623 := position
+				{
+					position624, tokenIndex624 := position, tokenIndex
+					if buffer[position] != rune('+') {
+						goto l625
+					}
+					position++
+					goto l624
+				l625:
+					position, tokenIndex = position624, tokenIndex624
+					if buffer[position] != rune('-') {
+						goto l626
+					}
+					position++
+					goto l624
+				l626:
+					position, tokenIndex = position624, tokenIndex624
+					if buffer[position] != rune('*') {
+						goto l627
+					}
+					position++
+					goto l624
+				l627:
+					position, tokenIndex = position624, tokenIndex624
+					if buffer[position] != rune('/') {
+						goto l622
+					}
+					position++
+				}
+			l624:
+				add(ruleArithmeticOperator, position623)
+			}
+			return true
+		l622:
+			position, tokenIndex = position622, tokenIndex622
+			return false
+		},
+		/* 33 CastValue <- <(<CastType> Action62 LPAR FilterValue RPAR Action63)> */
+		func() bool {
+			position628, tokenIndex628 := position, tokenIndex
+			{
+				position629 := position
+				{
+					position630 := position
+					if !_rules[ruleCastType]() {
+						goto l628
+					}
+					add(rulePegText, position630)
+				}
+				if !_rules[ruleAction62]() {
+					goto l628
+				}
+				if !_rules[ruleLPAR]() {
+					goto l628
+				}
+				if !_rules[ruleFilterValue]() {
+					goto l628
+				}
+				if !_rules[ruleRPAR]() {
+					goto l628
+				}
+				if !_rules[ruleAction63]() {
+					goto l628
+				}
+				add(ruleCastValue, position629)
+			}
+			return true
+		l628:
+			position, tokenIndex = position628, tokenIndex628
+			return false
+		},
+		/* 34 CastType <- <(((('i' / 'I') ('n' / 'N') ('t' / 'T')) / (('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T')) / (('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G')) / (('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))) !IdChar)> */
+		func() bool {
+			position631, tokenIndex631 := position, tokenIndex
+			{
+				position632 := position
+				{
+					position633, tokenIndex633 := position, tokenIndex
+					{
+						position635, tokenIndex635 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l636
+						}
+						position++
+						goto l635
+					l636:
+						position, tokenIndex = position635, tokenIndex635
+						if buffer[position] != rune('I') {
+							goto l634
+						}
+						position++
+					}
+				l635:
+					{
+						position637, tokenIndex637 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l638
+						}
+						position++
+						goto l637
+					l638:
+						position, tokenIndex = position637, tokenIndex637
+						if buffer[position] != rune('N') {
+							goto l634
+						}
+						position++
+					}
+				l637:
+					{
+						position639, tokenIndex639 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l640
+						}
+						position++
+						goto l639
+					l640:
+						position, tokenIndex = position639, tokenIndex639
+						if buffer[position] != rune('T') {
+							goto l634
+						}
+						position++
+					}
+				l639:
+					goto l633
+				l634:
+					position, tokenIndex = position633, tokenIndex633
+					{
+						position642, tokenIndex642 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l643
+						}
+						position++
+						goto l642
+					l643:
+						position, tokenIndex = position642, tokenIndex642
+						if buffer[position] != rune('F') {
+							goto l641
+						}
+						position++
+					}
+				l642:
+					{
+						position644, tokenIndex644 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l645
+						}
+						position++
+						goto l644
+					l645:
+						position, tokenIndex = position644, tokenIndex644
+						if buffer[position] != rune('L') {
+							goto l641
+						}
+						position++
+					}
+				l644:
+					{
+						position646, tokenIndex646 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l647
+						}
+						position++
+						goto l646
+					l647:
+						position, tokenIndex = position646, tokenIndex646
+						if buffer[position] != rune('O') {
+							goto l641
+						}
+						position++
+					}
+				l646:
+					{
+						position648, tokenIndex648 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l649
+						}
+						position++
+						goto l648
+					l649:
+						position, tokenIndex = position648, tokenIndex648
+						if buffer[position] != rune('A') {
+							goto l641
+						}
+						position++
+					}
+				l648:
+					{
+						position650, tokenIndex650 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l651
+						}
+						position++
+						goto l650
+					l651:
+						position, tokenIndex = position650, tokenIndex650
+						if buffer[position] != rune('T') {
+							goto l641
+						}
+						position++
+					}
+				l650:
+					goto l633
+				l641:
+					position, tokenIndex = position633, tokenIndex633
+					{
+						position653, tokenIndex653 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l654
+						}
+						position++
+						goto l653
+					l654:
+						position, tokenIndex = position653, tokenIndex653
+						if buffer[position] != rune('S') {
+							goto l652
+						}
+						position++
+					}
+				l653:
+					{
+						position655, tokenIndex655 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l656
+						}
+						position++
+						goto l655
+					l656:
+						position, tokenIndex = position655, tokenIndex655
+						if buffer[position] != rune('T') {
+							goto l652
+						}
+						position++
+					}
+				l655:
+					{
+						position657, tokenIndex657 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l658
+						}
+						position++
+						goto l657
+					l658:
+						position, tokenIndex = position657, tokenIndex657
+						if buffer[position] != rune('R') {
+							goto l652
+						}
+						position++
+					}
+				l657:
+					{
+						position659, tokenIndex659 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l660
+						}
+						position++
+						goto l659
+					l660:
+						position, tokenIndex = position659, tokenIndex659
+						if buffer[position] != rune('I') {
+							goto l652
+						}
+						position++
+					}
+				l659:
+					{
+						position661, tokenIndex661 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l662
+						}
+						position++
+						goto l661
+					l662:
+						position, tokenIndex = position661, tokenIndex661
+						if buffer[position] != rune('N') {
+							goto l652
+						}
+						position++
+					}
+				l661:
+					{
+						position663, tokenIndex663 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l664
+						}
+						position++
+						goto l663
+					l664:
+						position, tokenIndex = position663, tokenIndex663
+						if buffer[position] != rune('G') {
+							goto l652
+						}
+						position++
+					}
+				l663:
+					goto l633
+				l652:
+					position, tokenIndex = position633, tokenIndex633
+					{
+						position665, tokenIndex665 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l666
+						}
+						position++
+						goto l665
+					l666:
+						position, tokenIndex = position665, tokenIndex665
+						if buffer[position] != rune('B') {
+							goto l631
+						}
+						position++
+					}
+				l665:
+					{
+						position667, tokenIndex667 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l668
+						}
+						position++
+						goto l667
+					l668:
+						position, tokenIndex = position667, tokenIndex667
+						if buffer[position] != rune('O') {
+							goto l631
+						}
+						position++
+					}
+				l667:
+					{
+						position669, tokenIndex669 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l670
+						}
+						position++
+						goto l669
+					l670:
+						position, tokenIndex = position669, tokenIndex669
+						if buffer[position] != rune('O') {
+							goto l631
+						}
+						position++
+					}
+				l669:
+					{
+						position671, tokenIndex671 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l672
+						}
+						position++
+						goto l671
+					l672:
+						position, tokenIndex = position671, tokenIndex671
+						if buffer[position] != rune('L') {
+							goto l631
+						}
+						position++
+					}
+				l671:
+				}
+			l633:
+				{
+					position673, tokenIndex673 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l673
+					}
+					goto l631
+				l673:
+					position, tokenIndex = position673, tokenIndex673
+				}
+				add(ruleCastType, position632)
+			}
+			return true
+		l631:
+			position, tokenIndex = position631, tokenIndex631
+			return false
+		},
+		/* 35 NowValue <- <(('n' / 'N') ('o' / 'O') ('w' / 'W') LPAR RPAR Action64 (<(Sign _ Unsigned)> Action65)?)> */
+		func() bool {
+			position674, tokenIndex674 := position, tokenIndex
+			{
+				position675 := position
+				{
+					position676, tokenIndex676 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l677
+					}
+					position++
+					goto l676
+				l677:
+					position, tokenIndex = position676, tokenIndex676
+					if buffer[position] != rune('N') {
+						goto l674
+					}
+					position++
+				}
+			l676:
+				{
+					position678, tokenIndex678 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l679
+					}
+					position++
+					goto l678
+				l679:
+					position, tokenIndex = position678, tokenIndex678
+					if buffer[position] != rune('O') {
+						goto l674
+					}
+					position++
+				}
+			l678:
+				{
+					position680, tokenIndex680 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l681
+					}
+					position++
+					goto l680
+				l681:
+					position, tokenIndex = position680, tokenIndex680
+					if buffer[position] != rune('W') {
+						goto l674
+					}
+					position++
+				}
+			l680:
+				if !_rules[ruleLPAR]() {
+					goto l674
+				}
+				if !_rules[ruleRPAR]() {
+					goto l674
+				}
+				if !_rules[ruleAction64]() {
+					goto l674
+				}
+				{
+					position682, tokenIndex682 := position, tokenIndex
+					{
+						position684 := position
+						if !_rules[ruleSign]() {
+							goto l682
+						}
+						if !_rules[rule_]() {
+							goto l682
+						}
+						if !_rules[ruleUnsigned]() {
+							goto l682
+						}
+						add(rulePegText, position684)
+					}
+					if !_rules[ruleAction65]() {
+						goto l682
+					}
+					goto l683
+				l682:
+					position, tokenIndex = position682, tokenIndex682
+				}
+			l683:
+				add(ruleNowValue, position675)
+			}
+			return true
+		l674:
+			position, tokenIndex = position674, tokenIndex674
+			return false
+		},
+		/* 36 Descending <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') Action66)> */
+		func() bool {
+			position685, tokenIndex685 := position, tokenIndex
+			{
+				position686 := position
+				{
+					position687, tokenIndex687 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l688
+					}
+					position++
+					goto l687
+				l688:
+					position, tokenIndex = position687, tokenIndex687
+					if buffer[position] != rune('D') {
+						goto l685
+					}
+					position++
+				}
+			l687:
+				{
+					position689, tokenIndex689 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l690
+					}
+					position++
+					goto l689
+				l690:
+					position, tokenIndex = position689, tokenIndex689
+					if buffer[position] != rune('E') {
+						goto l685
+					}
+					position++
+				}
+			l689:
+				{
+					position691, tokenIndex691 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l692
+					}
+					position++
+					goto l691
+				l692:
+					position, tokenIndex = position691, tokenIndex691
+					if buffer[position] != rune('S') {
+						goto l685
+					}
+					position++
+				}
+			l691:
+				{
+					position693, tokenIndex693 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l694
+					}
+					position++
+					goto l693
+				l694:
+					position, tokenIndex = position693, tokenIndex693
+					if buffer[position] != rune('C') {
+						goto l685
+					}
+					position++
+				}
+			l693:
+				if !_rules[ruleAction66]() {
+					goto l685
+				}
+				add(ruleDescending, position686)
+			}
+			return true
+		l685:
+			position, tokenIndex = position685, tokenIndex685
+			return false
+		},
+		/* 37 Ascending <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') Action67)> */
+		func() bool {
+			position695, tokenIndex695 := position, tokenIndex
+			{
+				position696 := position
+				{
+					position697, tokenIndex697 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l698
+					}
+					position++
+					goto l697
+				l698:
+					position, tokenIndex = position697, tokenIndex697
+					if buffer[position] != rune('A') {
+						goto l695
+					}
+					position++
+				}
+			l697:
+				{
+					position699, tokenIndex699 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l700
+					}
+					position++
+					goto l699
+				l700:
+					position, tokenIndex = position699, tokenIndex699
+					if buffer[position] != rune('S') {
+						goto l695
+					}
+					position++
+				}
+			l699:
+				{
+					position701, tokenIndex701 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l702
+					}
+					position++
+					goto l701
+				l702:
+					position, tokenIndex = position701, tokenIndex701
+					if buffer[position] != rune('C') {
+						goto l695
+					}
+					position++
+				}
+			l701:
+				if !_rules[ruleAction67]() {
+					goto l695
+				}
+				add(ruleAscending, position696)
+			}
+			return true
+		l695:
+			position, tokenIndex = position695, tokenIndex695
+			return false
+		},
+		/* 38 String <- <('"' <StringChar*> '"')+> */
+		func() bool {
+			position703, tokenIndex703 := position, tokenIndex
+			{
+				position704 := position
+				if buffer[position] != rune('"') {
+					goto l703
+				}
+				position++
+				{
+					position707 := position
+				l708:
+					{
+						position709, tokenIndex709 := position, tokenIndex
+						if !_rules[ruleStringChar]() {
+							goto l709
+						}
+						goto l708
+					l709:
+						position, tokenIndex = position709, tokenIndex709
+					}
+					add(rulePegText, position707)
+				}
+				if buffer[position] != rune('"') {
+					goto l703
+				}
+				position++
+			l705:
+				{
+					position706, tokenIndex706 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l706
+					}
+					position++
+					{
+						position710 := position
+					l711:
+						{
+							position712, tokenIndex712 := position, tokenIndex
+							if !_rules[ruleStringChar]() {
+								goto l712
+							}
+							goto l711
+						l712:
+							position, tokenIndex = position712, tokenIndex712
+						}
+						add(rulePegText, position710)
+					}
+					if buffer[position] != rune('"') {
+						goto l706
+					}
+					position++
+					goto l705
+				l706:
+					position, tokenIndex = position706, tokenIndex706
+				}
+				add(ruleString, position704)
+			}
+			return true
+		l703:
+			position, tokenIndex = position703, tokenIndex703
+			return false
+		},
+		/* 39 StringChar <- <(Escape / (!('"' / '\n' / '\\') .))> */
+		func() bool {
+			position713, tokenIndex713 := position, tokenIndex
+			{
+				position714 := position
+				{
+					position715, tokenIndex715 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l716
+					}
+					goto l715
+				l716:
+					position, tokenIndex = position715, tokenIndex715
+					{
+						position717, tokenIndex717 := position, tokenIndex
+						{
+							position718, tokenIndex718 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l719
+							}
+							position++
+							goto l718
+						l719:
+							position, tokenIndex = position718, tokenIndex718
+							if buffer[position] != rune('\n') {
+								goto l720
+							}
+							position++
+							goto l718
+						l720:
+							position, tokenIndex = position718, tokenIndex718
+							if buffer[position] != rune('\\') {
+								goto l717
+							}
+							position++
+						}
+					l718:
+						goto l713
+					l717:
+						position, tokenIndex = position717, tokenIndex717
+					}
+					if !matchDot() {
+						goto l713
+					}
+				}
+			l715:
+				add(ruleStringChar, position714)
+			}
+			return true
+		l713:
+			position, tokenIndex = position713, tokenIndex713
+			return false
+		},
+		/* 40 Escape <- <(SimpleEscape / OctalEscape / HexEscape / UniversalCharacter)> */
+		func() bool {
+			position721, tokenIndex721 := position, tokenIndex
+			{
+				position722 := position
+				{
+					position723, tokenIndex723 := position, tokenIndex
+					if !_rules[ruleSimpleEscape]() {
+						goto l724
+					}
+					goto l723
+				l724:
+					position, tokenIndex = position723, tokenIndex723
+					if !_rules[ruleOctalEscape]() {
+						goto l725
+					}
+					goto l723
+				l725:
+					position, tokenIndex = position723, tokenIndex723
+					if !_rules[ruleHexEscape]() {
+						goto l726
+					}
+					goto l723
+				l726:
+					position, tokenIndex = position723, tokenIndex723
+					if !_rules[ruleUniversalCharacter]() {
+						goto l721
+					}
+				}
+			l723:
+				add(ruleEscape, position722)
+			}
+			return true
+		l721:
+			position, tokenIndex = position721, tokenIndex721
+			return false
+		},
+		/* 41 SimpleEscape <- <('\\' ('\'' / '"' / '?' / '\\' / 'a' / 'b' / 'f' / 'n' / 'r' / 't' / 'v' / '%' / '_'))> */
+		func() bool {
+			position727, tokenIndex727 := position, tokenIndex
+			{
+				position728 := position
+				if buffer[position] != rune('\\') {
+					goto l727
+				}
+				position++
+				{
+					position729, tokenIndex729 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l730
+					}
+					position++
+					goto l729
+				l730:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('"') {
+						goto l731
+					}
+					position++
+					goto l729
+				l731:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('?') {
+						goto l732
+					}
+					position++
+					goto l729
+				l732:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('\\') {
+						goto l733
+					}
+					position++
+					goto l729
+				l733:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('a') {
+						goto l734
+					}
+					position++
+					goto l729
+				l734:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('b') {
+						goto l735
+					}
+					position++
+					goto l729
+				l735:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('f') {
+						goto l736
+					}
+					position++
+					goto l729
+				l736:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('n') {
+						goto l737
+					}
+					position++
+					goto l729
+				l737:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('r') {
+						goto l738
+					}
+					position++
+					goto l729
+				l738:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('t') {
+						goto l739
+					}
+					position++
+					goto l729
+				l739:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('v') {
+						goto l740
+					}
+					position++
+					goto l729
+				l740:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('%') {
+						goto l741
+					}
+					position++
+					goto l729
+				l741:
+					position, tokenIndex = position729, tokenIndex729
+					if buffer[position] != rune('_') {
+						goto l727
+					}
+					position++
+				}
+			l729:
+				add(ruleSimpleEscape, position728)
+			}
+			return true
+		l727:
+			position, tokenIndex = position727, tokenIndex727
+			return false
+		},
+		/* 42 OctalEscape <- <('\\' [0-7] [0-7]? [0-7]?)> */
+		func() bool {
+			position742, tokenIndex742 := position, tokenIndex
+			{
+				position743 := position
+				if buffer[position] != rune('\\') {
+					goto l742
+				}
+				position++
+				if c := buffer[position]; c < rune('0') || c > rune('7') {
+					goto l742
+				}
+				position++
+				{
+					position744, tokenIndex744 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('7') {
+						goto l744
+					}
+					position++
+					goto l745
+				l744:
+					position, tokenIndex = position744, tokenIndex744
+				}
+			l745:
+				{
+					position746, tokenIndex746 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('7') {
+						goto l746
+					}
+					position++
+					goto l747
+				l746:
+					position, tokenIndex = position746, tokenIndex746
+				}
+			l747:
+				add(ruleOctalEscape, position743)
+			}
+			return true
+		l742:
+			position, tokenIndex = position742, tokenIndex742
+			return false
+		},
+		/* 43 HexEscape <- <('\\' 'x' HexDigit+)> */
+		func() bool {
+			position748, tokenIndex748 := position, tokenIndex
+			{
+				position749 := position
+				if buffer[position] != rune('\\') {
+					goto l748
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l748
+				}
+				position++
+				if !_rules[ruleHexDigit]() {
+					goto l748
+				}
+			l750:
+				{
+					position751, tokenIndex751 := position, tokenIndex
+					if !_rules[ruleHexDigit]() {
+						goto l751
+					}
+					goto l750
+				l751:
+					position, tokenIndex = position751, tokenIndex751
+				}
+				add(ruleHexEscape, position749)
+			}
+			return true
+		l748:
+			position, tokenIndex = position748, tokenIndex748
+			return false
+		},
+		/* 44 UniversalCharacter <- <(('\\' 'u' HexQuad) / ('\\' 'U' HexQuad HexQuad))> */
+		func() bool {
+			position752, tokenIndex752 := position, tokenIndex
+			{
+				position753 := position
+				{
+					position754, tokenIndex754 := position, tokenIndex
+					if buffer[position] != rune('\\') {
+						goto l755
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l755
+					}
+					position++
+					if !_rules[ruleHexQuad]() {
+						goto l755
+					}
+					goto l754
+				l755:
+					position, tokenIndex = position754, tokenIndex754
+					if buffer[position] != rune('\\') {
+						goto l752
+					}
+					position++
+					if buffer[position] != rune('U') {
+						goto l752
+					}
+					position++
+					if !_rules[ruleHexQuad]() {
+						goto l752
+					}
+					if !_rules[ruleHexQuad]() {
+						goto l752
+					}
+				}
+			l754:
+				add(ruleUniversalCharacter, position753)
+			}
+			return true
+		l752:
+			position, tokenIndex = position752, tokenIndex752
+			return false
+		},
+		/* 45 HexQuad <- <(HexDigit HexDigit HexDigit HexDigit)> */
+		func() bool {
+			position756, tokenIndex756 := position, tokenIndex
+			{
+				position757 := position
+				if !_rules[ruleHexDigit]() {
+					goto l756
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l756
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l756
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l756
+				}
+				add(ruleHexQuad, position757)
+			}
+			return true
+		l756:
+			position, tokenIndex = position756, tokenIndex756
+			return false
+		},
+		/* 46 HexDigit <- <([a-f] / [A-F] / [0-9])> */
+		func() bool {
+			position758, tokenIndex758 := position, tokenIndex
+			{
+				position759 := position
+				{
+					position760, tokenIndex760 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('f') {
+						goto l761
+					}
+					position++
+					goto l760
+				l761:
+					position, tokenIndex = position760, tokenIndex760
+					if c := buffer[position]; c < rune('A') || c > rune('F') {
+						goto l762
+					}
+					position++
+					goto l760
+				l762:
+					position, tokenIndex = position760, tokenIndex760
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l758
+					}
+					position++
+				}
+			l760:
+				add(ruleHexDigit, position759)
+			}
+			return true
+		l758:
+			position, tokenIndex = position758, tokenIndex758
+			return false
+		},
+		/* 47 Unsigned <- <[0-9]+> */
+		func() bool {
+			position763, tokenIndex763 := position, tokenIndex
+			{
+				position764 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l763
+				}
+				position++
+			l765:
+				{
+					position766, tokenIndex766 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l766
+					}
+					position++
+					goto l765
+				l766:
+					position, tokenIndex = position766, tokenIndex766
+				}
+				add(ruleUnsigned, position764)
+			}
+			return true
+		l763:
+			position, tokenIndex = position763, tokenIndex763
+			return false
+		},
+		/* 48 Sign <- <('-' / '+')> */
+		func() bool {
+			position767, tokenIndex767 := position, tokenIndex
+			{
+				position768 := position
+				{
+					position769, tokenIndex769 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l770
+					}
+					position++
+					goto l769
+				l770:
+					position, tokenIndex = position769, tokenIndex769
+					if buffer[position] != rune('+') {
+						goto l767
+					}
+					position++
+				}
+			l769:
+				add(ruleSign, position768)
+			}
+			return true
+		l767:
+			position, tokenIndex = position767, tokenIndex767
+			return false
+		},
+		/* 49 Integer <- <<(Sign? (HexNumeral / BinaryNumeral / OctalNumeral / Unsigned))>> */
+		func() bool {
+			position771, tokenIndex771 := position, tokenIndex
+			{
+				position772 := position
+				{
+					position773 := position
+					{
+						position774, tokenIndex774 := position, tokenIndex
+						if !_rules[ruleSign]() {
+							goto l774
+						}
+						goto l775
+					l774:
+						position, tokenIndex = position774, tokenIndex774
+					}
+				l775:
+					{
+						position776, tokenIndex776 := position, tokenIndex
+						if !_rules[ruleHexNumeral]() {
+							goto l777
+						}
+						goto l776
+					l777:
+						position, tokenIndex = position776, tokenIndex776
+						if !_rules[ruleBinaryNumeral]() {
+							goto l778
+						}
+						goto l776
+					l778:
+						position, tokenIndex = position776, tokenIndex776
+						if !_rules[ruleOctalNumeral]() {
+							goto l779
+						}
+						goto l776
+					l779:
+						position, tokenIndex = position776, tokenIndex776
+						if !_rules[ruleUnsigned]() {
+							goto l771
+						}
+					}
+				l776:
+					add(rulePegText, position773)
+				}
+				add(ruleInteger, position772)
+			}
+			return true
+		l771:
+			position, tokenIndex = position771, tokenIndex771
+			return false
+		},
+		/* 50 HexNumeral <- <('0' ('x' / 'X') HexDigit+)> */
+		func() bool {
+			position780, tokenIndex780 := position, tokenIndex
+			{
+				position781 := position
+				if buffer[position] != rune('0') {
+					goto l780
+				}
+				position++
+				{
+					position782, tokenIndex782 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l783
+					}
+					position++
+					goto l782
+				l783:
+					position, tokenIndex = position782, tokenIndex782
+					if buffer[position] != rune('X') {
+						goto l780
+					}
+					position++
+				}
+			l782:
+				if !_rules[ruleHexDigit]() {
+					goto l780
+				}
+			l784:
+				{
+					position785, tokenIndex785 := position, tokenIndex
+					if !_rules[ruleHexDigit]() {
+						goto l785
+					}
+					goto l784
+				l785:
+					position, tokenIndex = position785, tokenIndex785
+				}
+				add(ruleHexNumeral, position781)
+			}
+			return true
+		l780:
+			position, tokenIndex = position780, tokenIndex780
+			return false
+		},
+		/* 51 BinaryNumeral <- <('0' ('b' / 'B') ('0' / '1')+)> */
+		func() bool {
+			position786, tokenIndex786 := position, tokenIndex
+			{
+				position787 := position
+				if buffer[position] != rune('0') {
+					goto l786
+				}
+				position++
+				{
+					position788, tokenIndex788 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l789
+					}
+					position++
+					goto l788
+				l789:
+					position, tokenIndex = position788, tokenIndex788
+					if buffer[position] != rune('B') {
+						goto l786
+					}
+					position++
+				}
+			l788:
+				{
+					position792, tokenIndex792 := position, tokenIndex
+					if buffer[position] != rune('0') {
+						goto l793
+					}
+					position++
+					goto l792
+				l793:
+					position, tokenIndex = position792, tokenIndex792
+					if buffer[position] != rune('1') {
+						goto l786
+					}
+					position++
+				}
+			l792:
+			l790:
+				{
+					position791, tokenIndex791 := position, tokenIndex
+					{
+						position794, tokenIndex794 := position, tokenIndex
+						if buffer[position] != rune('0') {
+							goto l795
+						}
+						position++
+						goto l794
+					l795:
+						position, tokenIndex = position794, tokenIndex794
+						if buffer[position] != rune('1') {
+							goto l791
+						}
+						position++
+					}
+				l794:
+					goto l790
+				l791:
+					position, tokenIndex = position791, tokenIndex791
+				}
+				add(ruleBinaryNumeral, position787)
+			}
+			return true
+		l786:
+			position, tokenIndex = position786, tokenIndex786
+			return false
+		},
+		/* 52 OctalNumeral <- <('0' ('o' / 'O') [0-7]+)> */
+		func() bool {
+			position796, tokenIndex796 := position, tokenIndex
+			{
+				position797 := position
+				if buffer[position] != rune('0') {
+					goto l796
+				}
+				position++
+				{
+					position798, tokenIndex798 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l799
+					}
+					position++
+					goto l798
+				l799:
+					position, tokenIndex = position798, tokenIndex798
+					if buffer[position] != rune('O') {
+						goto l796
+					}
+					position++
+				}
+			l798:
+				if c := buffer[position]; c < rune('0') || c > rune('7') {
+					goto l796
+				}
+				position++
+			l800:
+				{
+					position801, tokenIndex801 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('7') {
+						goto l801
+					}
+					position++
+					goto l800
+				l801:
+					position, tokenIndex = position801, tokenIndex801
+				}
+				add(ruleOctalNumeral, position797)
+			}
+			return true
+		l796:
+			position, tokenIndex = position796, tokenIndex796
+			return false
+		},
+		/* 53 Float <- <(Sign? Unsigned (('.' Unsigned Exponent?) / Exponent))> */
+		func() bool {
+			position802, tokenIndex802 := position, tokenIndex
+			{
+				position803 := position
+				{
+					position804, tokenIndex804 := position, tokenIndex
+					if !_rules[ruleSign]() {
+						goto l804
+					}
+					goto l805
+				l804:
+					position, tokenIndex = position804, tokenIndex804
+				}
+			l805:
+				if !_rules[ruleUnsigned]() {
+					goto l802
+				}
+				{
+					position806, tokenIndex806 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l807
+					}
+					position++
+					if !_rules[ruleUnsigned]() {
+						goto l807
+					}
+					{
+						position808, tokenIndex808 := position, tokenIndex
+						if !_rules[ruleExponent]() {
+							goto l808
+						}
+						goto l809
+					l808:
+						position, tokenIndex = position808, tokenIndex808
+					}
+				l809:
+					goto l806
+				l807:
+					position, tokenIndex = position806, tokenIndex806
+					if !_rules[ruleExponent]() {
+						goto l802
+					}
+				}
+			l806:
+				add(ruleFloat, position803)
+			}
+			return true
+		l802:
+			position, tokenIndex = position802, tokenIndex802
+			return false
+		},
+		/* 54 Exponent <- <(('e' / 'E') Sign? Unsigned)> */
+		func() bool {
+			position810, tokenIndex810 := position, tokenIndex
+			{
+				position811 := position
+				{
+					position812, tokenIndex812 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l813
+					}
+					position++
+					goto l812
+				l813:
+					position, tokenIndex = position812, tokenIndex812
+					if buffer[position] != rune('E') {
+						goto l810
+					}
+					position++
+				}
+			l812:
+				{
+					position814, tokenIndex814 := position, tokenIndex
+					if !_rules[ruleSign]() {
+						goto l814
+					}
+					goto l815
+				l814:
+					position, tokenIndex = position814, tokenIndex814
+				}
+			l815:
+				if !_rules[ruleUnsigned]() {
+					goto l810
+				}
+				add(ruleExponent, position811)
+			}
+			return true
+		l810:
+			position, tokenIndex = position810, tokenIndex810
+			return false
+		},
+		/* 55 Identifier <- <(!Keyword <(([a-z] / [A-Z] / '_') IdChar*)>)> */
+		func() bool {
+			position816, tokenIndex816 := position, tokenIndex
+			{
+				position817 := position
+				{
+					position818, tokenIndex818 := position, tokenIndex
+					if !_rules[ruleKeyword]() {
+						goto l818
+					}
+					goto l816
+				l818:
+					position, tokenIndex = position818, tokenIndex818
+				}
+				{
+					position819 := position
+					{
+						position820, tokenIndex820 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l821
+						}
+						position++
+						goto l820
+					l821:
+						position, tokenIndex = position820, tokenIndex820
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l822
+						}
+						position++
+						goto l820
+					l822:
+						position, tokenIndex = position820, tokenIndex820
+						if buffer[position] != rune('_') {
+							goto l816
+						}
+						position++
+					}
+				l820:
+				l823:
+					{
+						position824, tokenIndex824 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l824
+						}
+						goto l823
+					l824:
+						position, tokenIndex = position824, tokenIndex824
+					}
+					add(rulePegText, position819)
+				}
+				add(ruleIdentifier, position817)
+			}
+			return true
+		l816:
+			position, tokenIndex = position816, tokenIndex816
+			return false
+		},
+		/* 56 IdChar <- <([a-z] / [A-Z] / [0-9] / '_')> */
+		func() bool {
+			position825, tokenIndex825 := position, tokenIndex
+			{
+				position826 := position
+				{
+					position827, tokenIndex827 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l828
+					}
+					position++
+					goto l827
+				l828:
+					position, tokenIndex = position827, tokenIndex827
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l829
+					}
+					position++
+					goto l827
+				l829:
+					position, tokenIndex = position827, tokenIndex827
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l830
+					}
+					position++
+					goto l827
+				l830:
+					position, tokenIndex = position827, tokenIndex827
+					if buffer[position] != rune('_') {
+						goto l825
+					}
+					position++
+				}
+			l827:
+				add(ruleIdChar, position826)
+			}
+			return true
+		l825:
+			position, tokenIndex = position825, tokenIndex825
+			return false
+		},
+		/* 57 Keyword <- <((('s' 'e' 'l' 'e' 'c' 't') / ('g' 'r' 'o' 'u' 'p' ' ' 'b' 'y') / ('f' 'i' 'l' 't' 'e' 'r' 's') / ('o' 'r' 'd' 'e' 'r' ' ' 'b' 'y') / ('d' 'e' 's' 'c') / ('a' 's' 'c') / ('l' 'i' 'm' 'i' 't') / ('o' 'f' 'f' 's' 'e' 't') / ('o' 'r') / ('a' 'n' 'd') / ('i' 'n') / ('b' 'e' 't' 'w' 'e' 'e' 'n') / ('i' 's') / ('n' 'u' 'l' 'l') / ('l' 'i' 'k' 'e') / ('i' 'l' 'i' 'k' 'e') / ('a' 's') / ('d' 'i' 's' 't' 'i' 'n' 'c' 't') / ('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e') / ('s' 't' 'a' 'r' 't' 's' '_' 'w' 'i' 't' 'h') / ('e' 'n' 'd' 's' '_' 'w' 'i' 't' 'h') / ('i' 's' 't' 'a' 'r' 't' 's' '_' 'w' 'i' 't' 'h') / ('i' 'e' 'n' 'd' 's' '_' 'w' 'i' 't' 'h') / ('i' 'n' '_' 'c' 'i' 'd' 'r')) !IdChar)> */
+		func() bool {
+			position831, tokenIndex831 := position, tokenIndex
+			{
+				position832 := position
+				{
+					position833, tokenIndex833 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l834
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l834
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l834
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l834
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l834
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l834
+					}
+					position++
+					goto l833
+				l834:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('g') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l835
+					}
+					position++
+					goto l833
+				l835:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('f') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l836
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l836
+					}
+					position++
+					goto l833
+				l836:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('o') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l837
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l837
+					}
+					position++
+					goto l833
+				l837:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('d') {
+						goto l838
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l838
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l838
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l838
+					}
+					position++
+					goto l833
+				l838:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('a') {
+						goto l839
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l839
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l839
+					}
+					position++
+					goto l833
+				l839:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('l') {
+						goto l840
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l840
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l840
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l840
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l840
+					}
+					position++
+					goto l833
+				l840:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('o') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l841
+					}
+					position++
+					goto l833
+				l841:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('o') {
+						goto l842
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l842
+					}
+					position++
+					goto l833
+				l842:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('a') {
+						goto l843
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l843
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l843
+					}
+					position++
+					goto l833
+				l843:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l844
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l844
+					}
+					position++
+					goto l833
+				l844:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('b') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l845
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l845
+					}
+					position++
+					goto l833
+				l845:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l846
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l846
+					}
+					position++
+					goto l833
+				l846:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('n') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l847
+					}
+					position++
+					goto l833
+				l847:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('l') {
+						goto l848
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l848
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l848
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l848
+					}
+					position++
+					goto l833
+				l848:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l849
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l849
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l849
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l849
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l849
+					}
+					position++
+					goto l833
+				l849:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('a') {
+						goto l850
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l850
+					}
+					position++
+					goto l833
+				l850:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('d') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l851
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l851
+					}
+					position++
+					goto l833
+				l851:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('t') {
+						goto l852
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l852
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l852
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l852
+					}
+					position++
+					goto l833
+				l852:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('f') {
+						goto l853
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l853
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l853
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l853
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l853
+					}
+					position++
+					goto l833
+				l853:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('s') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l854
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l854
+					}
+					position++
+					goto l833
+				l854:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('e') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l855
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l855
+					}
+					position++
+					goto l833
+				l855:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l856
+					}
+					position++
+					goto l833
+				l856:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l857
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l857
+					}
+					position++
+					goto l833
+				l857:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('i') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l831
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l831
+					}
+					position++
+				}
+			l833:
+				{
+					position858, tokenIndex858 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l858
+					}
+					goto l831
+				l858:
+					position, tokenIndex = position858, tokenIndex858
+				}
+				add(ruleKeyword, position832)
+			}
+			return true
+		l831:
+			position, tokenIndex = position831, tokenIndex831
+			return false
+		},
+		/* 58 _ <- <(' ' / '\t' / ('\r' '\n') / '\n' / '\r' / Comment)*> */
+		func() bool {
+			{
+				position860 := position
+			l861:
+				{
+					position862, tokenIndex862 := position, tokenIndex
+					{
+						position863, tokenIndex863 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l864
+						}
+						position++
+						goto l863
+					l864:
+						position, tokenIndex = position863, tokenIndex863
+						if buffer[position] != rune('\t') {
+							goto l865
+						}
+						position++
+						goto l863
+					l865:
+						position, tokenIndex = position863, tokenIndex863
+						if buffer[position] != rune('\r') {
+							goto l866
+						}
+						position++
+						if buffer[position] != rune('\n') {
+							goto l866
+						}
+						position++
+						goto l863
+					l866:
+						position, tokenIndex = position863, tokenIndex863
+						if buffer[position] != rune('\n') {
+							goto l867
+						}
+						position++
+						goto l863
+					l867:
+						position, tokenIndex = position863, tokenIndex863
+						if buffer[position] != rune('\r') {
+							goto l868
+						}
+						position++
+						goto l863
+					l868:
+						position, tokenIndex = position863, tokenIndex863
+						if !_rules[ruleComment]() {
+							goto l862
+						}
+					}
+				l863:
+					goto l861
+				l862:
+					position, tokenIndex = position862, tokenIndex862
+				}
+				add(rule_, position860)
+			}
+			return true
+		},
+		/* 59 Comment <- <('-' '-' <(!('\r' / '\n') .)*> Action68)> */
+		func() bool {
+			position869, tokenIndex869 := position, tokenIndex
+			{
+				position870 := position
+				if buffer[position] != rune('-') {
+					goto l869
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l869
+				}
+				position++
+				{
+					position871 := position
+				l872:
+					{
+						position873, tokenIndex873 := position, tokenIndex
+						{
+							position874, tokenIndex874 := position, tokenIndex
+							{
+								position875, tokenIndex875 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									goto l876
+								}
+								position++
+								goto l875
+							l876:
+								position, tokenIndex = position875, tokenIndex875
+								if buffer[position] != rune('\n') {
+									goto l874
+								}
+								position++
+							}
+						l875:
+							goto l873
+						l874:
+							position, tokenIndex = position874, tokenIndex874
+						}
+						if !matchDot() {
+							goto l873
+						}
+						goto l872
+					l873:
+						position, tokenIndex = position873, tokenIndex873
+					}
+					add(rulePegText, position871)
+				}
+				if !_rules[ruleAction68]() {
+					goto l869
+				}
+				add(ruleComment, position870)
+			}
+			return true
+		l869:
+			position, tokenIndex = position869, tokenIndex869
+			return false
+		},
+		/* 60 LPAR <- <(_ '(' _)> */
+		func() bool {
+			position877, tokenIndex877 := position, tokenIndex
+			{
+				position878 := position
+				if !_rules[rule_]() {
+					goto l877
+				}
+				if buffer[position] != rune('(') {
+					goto l877
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l877
+				}
+				add(ruleLPAR, position878)
+			}
+			return true
+		l877:
+			position, tokenIndex = position877, tokenIndex877
+			return false
+		},
+		/* 61 RPAR <- <(_ ')' _)> */
+		func() bool {
+			position879, tokenIndex879 := position, tokenIndex
+			{
+				position880 := position
+				if !_rules[rule_]() {
+					goto l879
+				}
+				if buffer[position] != rune(')') {
+					goto l879
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l879
+				}
+				add(ruleRPAR, position880)
+			}
+			return true
+		l879:
+			position, tokenIndex = position879, tokenIndex879
+			return false
+		},
+		/* 62 COMMA <- <(_ ',' _)> */
+		func() bool {
+			position881, tokenIndex881 := position, tokenIndex
+			{
+				position882 := position
+				if !_rules[rule_]() {
+					goto l881
+				}
+				if buffer[position] != rune(',') {
+					goto l881
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l881
+				}
+				add(ruleCOMMA, position882)
+			}
+			return true
+		l881:
+			position, tokenIndex = position881, tokenIndex881
+			return false
+		},
+		/* 64 Action0 <- <{ p.currentSection = "columns" }> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 65 Action1 <- <{ p.SetDistinct() }> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 66 Action2 <- <{ p.currentSection = "columns" }> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 67 Action3 <- <{ p.currentSection = "distinct on" }> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 68 Action4 <- <{ p.currentSection = "group by" }> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 69 Action5 <- <{ p.currentSection = "order by" }> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 70 Action6 <- <{ p.SetLimitAll() }> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		nil,
+		/* 72 Action7 <- <{ p.SetLimit(text) }> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 73 Action8 <- <{ p.SetOffset(text) }> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 74 Action9 <- <{ p.AddColumn() }> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 75 Action10 <- <{ p.SetColumnName(text) }> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 76 Action11 <- <{ p.SetColumnName(text) }> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 77 Action12 <- <{ p.SetColumnAlias(text) }> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 78 Action13 <- <{ p.SetColumnFunction(text)  }> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 79 Action14 <- <{ p.SetColumnName(text)     }> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 80 Action15 <- <{ p.AddColumnArgument(text)  }> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 81 Action16 <- <{ p.AddColumnValueFloat(text) }> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 82 Action17 <- <{ p.AddColumnValueInteger(text) }> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 83 Action18 <- <{ p.AddColumnValueString(text) }> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 84 Action19 <- <{ p.AddColumnValueBool(text) }> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 85 Action20 <- <{ p.SetColumnAggregate(text) }> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 86 Action21 <- <{ p.BeginColumnFilters() }> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 87 Action22 <- <{ p.EndColumnFilters() }> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 88 Action23 <- <{ p.BeginOr() }> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 89 Action24 <- <{ p.NextOrAlternative() }> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 90 Action25 <- <{ p.EndOr() }> */
+		func() bool {
+			{
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 91 Action26 <- <{ p.AddFilter() }> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 92 Action27 <- <{ p.AddFilter() }> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 93 Action28 <- <{ p.SetFilterQuantifier(text) }> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 94 Action29 <- <{ p.AddFilter() }> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 95 Action30 <- <{ p.SetFilterOperator(text) }> */
+		func() bool {
+			{
+				add(ruleAction30, position)
+			}
+			return true
+		},
+		/* 96 Action31 <- <{ p.BeginFilterList() }> */
+		func() bool {
+			{
+				add(ruleAction31, position)
+			}
+			return true
+		},
+		/* 97 Action32 <- <{ p.AddFilterListValue() }> */
+		func() bool {
+			{
+				add(ruleAction32, position)
+			}
+			return true
+		},
+		/* 98 Action33 <- <{ p.AddFilterListValue() }> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 99 Action34 <- <{ p.EndFilterList() }> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 100 Action35 <- <{ p.SetFilterOperator("is not null") }> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 101 Action36 <- <{ p.SetFilterOperator("is null") }> */
+		func() bool {
+			{
+				add(ruleAction36, position)
+			}
+			return true
+		},
+		/* 102 Action37 <- <{ p.SetFilterOperator("not between") }> */
+		func() bool {
+			{
+				add(ruleAction37, position)
+			}
+			return true
+		},
+		/* 103 Action38 <- <{ p.SetFilterOperator(text) }> */
+		func() bool {
+			{
+				add(ruleAction38, position)
+			}
+			return true
+		},
+		/* 104 Action39 <- <{ p.BeginFilterList() }> */
+		func() bool {
+			{
+				add(ruleAction39, position)
+			}
+			return true
+		},
+		/* 105 Action40 <- <{ p.AddFilterListValue() }> */
+		func() bool {
+			{
+				add(ruleAction40, position)
+			}
+			return true
+		},
+		/* 106 Action41 <- <{ p.AddFilterListValue() }> */
+		func() bool {
+			{
+				add(ruleAction41, position)
+			}
+			return true
+		},
+		/* 107 Action42 <- <{ p.EndFilterList() }> */
+		func() bool {
+			{
+				add(ruleAction42, position)
 			}
 			return true
-		l235:
-			position, tokenIndex = position235, tokenIndex235
-			return false
 		},
-		/* 29 IdChar <- <([a-z] / [A-Z] / [0-9] / '_')> */
+		/* 108 Action43 <- <{ p.SetFilterSample(text) }> */
 		func() bool {
-			position244, tokenIndex244 := position, tokenIndex
 			{
-				position245 := position
-				{
-					position246, tokenIndex246 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l247
-					}
-					position++
-					goto l246
-				l247:
-					position, tokenIndex = position246, tokenIndex246
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l248
-					}
-					position++
-					goto l246
-				l248:
-					position, tokenIndex = position246, tokenIndex246
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l249
-					}
-					position++
-					goto l246
-				l249:
-					position, tokenIndex = position246, tokenIndex246
-					if buffer[position] != rune('_') {
-						goto l244
-					}
-					position++
-				}
-			l246:
-				add(ruleIdChar, position245)
+				add(ruleAction43, position)
 			}
 			return true
-		l244:
-			position, tokenIndex = position244, tokenIndex244
-			return false
 		},
-		/* 30 Keyword <- <((('s' 'e' 'l' 'e' 'c' 't') / ('g' 'r' 'o' 'u' 'p' ' ' 'b' 'y') / ('f' 'i' 'l' 't' 'e' 'r' 's') / ('o' 'r' 'd' 'e' 'r' ' ' 'b' 'y') / ('d' 'e' 's' 'c') / ('l' 'i' 'm' 'i' 't')) !IdChar)> */
+		/* 109 Action44 <- <{ p.SetFilterColumn(text) }> */
 		func() bool {
-			position250, tokenIndex250 := position, tokenIndex
 			{
-				position251 := position
-				{
-					position252, tokenIndex252 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l253
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l253
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l253
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l253
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l253
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l253
-					}
-					position++
-					goto l252
-				l253:
-					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('g') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune(' ') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l254
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l254
-					}
-					position++
-					goto l252
-				l254:
-					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('f') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l255
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l255
-					}
-					position++
-					goto l252
-				l255:
-					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('o') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune(' ') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l256
-					}
-					position++
-					goto l252
-				l256:
-					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('d') {
-						goto l257
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l257
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l257
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l257
-					}
-					position++
-					goto l252
-				l257:
-					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('l') {
-						goto l250
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l250
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l250
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l250
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l250
-					}
-					position++
-				}
-			l252:
-				{
-					position258, tokenIndex258 := position, tokenIndex
-					if !_rules[ruleIdChar]() {
-						goto l258
-					}
-					goto l250
-				l258:
-					position, tokenIndex = position258, tokenIndex258
-				}
-				add(ruleKeyword, position251)
+				add(ruleAction44, position)
 			}
 			return true
-		l250:
-			position, tokenIndex = position250, tokenIndex250
-			return false
 		},
-		/* 31 _ <- <(' ' / '\t' / ('\r' '\n') / '\n' / '\r')*> */
+		/* 110 Action45 <- <{ p.SetFilterFunction(text) }> */
 		func() bool {
 			{
-				position260 := position
-			l261:
-				{
-					position262, tokenIndex262 := position, tokenIndex
-					{
-						position263, tokenIndex263 := position, tokenIndex
-						if buffer[position] != rune(' ') {
-							goto l264
-						}
-						position++
-						goto l263
-					l264:
-						position, tokenIndex = position263, tokenIndex263
-						if buffer[position] != rune('\t') {
-							goto l265
-						}
-						position++
-						goto l263
-					l265:
-						position, tokenIndex = position263, tokenIndex263
-						if buffer[position] != rune('\r') {
-							goto l266
-						}
-						position++
-						if buffer[position] != rune('\n') {
-							goto l266
-						}
-						position++
-						goto l263
-					l266:
-						position, tokenIndex = position263, tokenIndex263
-						if buffer[position] != rune('\n') {
-							goto l267
-						}
-						position++
-						goto l263
-					l267:
-						position, tokenIndex = position263, tokenIndex263
-						if buffer[position] != rune('\r') {
-							goto l262
-						}
-						position++
-					}
-				l263:
-					goto l261
-				l262:
-					position, tokenIndex = position262, tokenIndex262
-				}
-				add(rule_, position260)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 32 LPAR <- <(_ '(' _)> */
+		/* 111 Action46 <- <{ p.SetFilterColumn(text) }> */
 		func() bool {
-			position268, tokenIndex268 := position, tokenIndex
 			{
-				position269 := position
-				if !_rules[rule_]() {
-					goto l268
-				}
-				if buffer[position] != rune('(') {
-					goto l268
-				}
-				position++
-				if !_rules[rule_]() {
-					goto l268
-				}
-				add(ruleLPAR, position269)
+				add(ruleAction46, position)
 			}
 			return true
-		l268:
-			position, tokenIndex = position268, tokenIndex268
-			return false
 		},
-		/* 33 RPAR <- <(_ ')' _)> */
+		/* 112 Action47 <- <{ p.AddFilterArgument(text) }> */
 		func() bool {
-			position270, tokenIndex270 := position, tokenIndex
 			{
-				position271 := position
-				if !_rules[rule_]() {
-					goto l270
-				}
-				if buffer[position] != rune(')') {
-					goto l270
-				}
-				position++
-				if !_rules[rule_]() {
-					goto l270
-				}
-				add(ruleRPAR, position271)
+				add(ruleAction47, position)
 			}
 			return true
-		l270:
-			position, tokenIndex = position270, tokenIndex270
-			return false
 		},
-		/* 34 COMMA <- <(_ ',' _)> */
+		/* 113 Action48 <- <{ p.SetFilterFunctionStar(text) }> */
 		func() bool {
-			position272, tokenIndex272 := position, tokenIndex
 			{
-				position273 := position
-				if !_rules[rule_]() {
-					goto l272
-				}
-				if buffer[position] != rune(',') {
-					goto l272
-				}
-				position++
-				if !_rules[rule_]() {
-					goto l272
-				}
-				add(ruleCOMMA, position273)
+				add(ruleAction48, position)
 			}
 			return true
-		l272:
-			position, tokenIndex = position272, tokenIndex272
-			return false
 		},
-		/* 36 Action0 <- <{ p.currentSection = "columns" }> */
+		/* 114 Action49 <- <{ p.SetFilterColumn(text) }> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 37 Action1 <- <{ p.currentSection = "group by" }> */
+		/* 115 Action50 <- <{ p.SetFilterOperator(text) }> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction50, position)
 			}
 			return true
 		},
-		/* 38 Action2 <- <{ p.currentSection = "order by" }> */
+		/* 116 Action51 <- <{ p.BeginFilterAlternative() }> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction51, position)
 			}
 			return true
 		},
-		nil,
-		/* 40 Action3 <- <{ p.SetLimit(text) }> */
+		/* 117 Action52 <- <{ p.EndFilterAlternative() }> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 41 Action4 <- <{ p.AddColumn() }> */
+		/* 118 Action53 <- <{ p.SetFilterValueFloat(text) }> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 42 Action5 <- <{ p.SetColumnName(text) }> */
+		/* 119 Action54 <- <{ p.SetFilterValueInteger(text) }> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 43 Action6 <- <{ p.SetColumnName(text) }> */
+		/* 120 Action55 <- <{ p.SetFilterValueString(text) }> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 44 Action7 <- <{ p.SetColumnAggregate(text) }> */
+		/* 121 Action56 <- <{ p.SetFilterValueParam(text) }> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 45 Action8 <- <{ p.SetColumnName(text)      }> */
+		/* 122 Action57 <- <{ p.SetFilterValueNull() }> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 46 Action9 <- <{ p.AddFilter() }> */
+		/* 123 Action58 <- <{ p.SetFilterValueBool(text) }> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 47 Action10 <- <{ p.SetFilterColumn(text) }> */
+		/* 124 Action59 <- <{ p.SetFilterValueColumn(text) }> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 48 Action11 <- <{ p.SetFilterOperator(text) }> */
+		/* 125 Action60 <- <{ p.SetFilterValueOperator(text) }> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 49 Action12 <- <{ p.SetFilterValueFloat(text) }> */
+		/* 126 Action61 <- <{ p.SetFilterValueOperand(text) }> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 50 Action13 <- <{ p.SetFilterValueInteger(text) }> */
+		/* 127 Action62 <- <{ p.BeginCast(text) }> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 51 Action14 <- <{ p.SetFilterValueString(text) }> */
+		/* 128 Action63 <- <{ p.EndCast() }> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 52 Action15 <- <{ p.SetDescending() }> */
+		/* 129 Action64 <- <{ p.SetFilterValueNow() }> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction64, position)
+			}
+			return true
+		},
+		/* 130 Action65 <- <{ p.SetFilterValueNowOffset(text) }> */
+		func() bool {
+			{
+				add(ruleAction65, position)
+			}
+			return true
+		},
+		/* 131 Action66 <- <{ p.SetDescending() }> */
+		func() bool {
+			{
+				add(ruleAction66, position)
+			}
+			return true
+		},
+		/* 132 Action67 <- <{ p.SetAscending() }> */
+		func() bool {
+			{
+				add(ruleAction67, position)
+			}
+			return true
+		},
+		/* 133 Action68 <- <{ p.AddComment(text) }> */
+		func() bool {
+			{
+				add(ruleAction68, position)
 			}
 			return true
 		},
@@ -0,0 +1,307 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A grouper collects the rows of a grouped query into groups of rows
+// with the same values for the GROUP BY columns, and aggregates the
+// rows of each group.
+type grouper struct {
+	columns  []ColumnDesc
+	selected []ColumnDesc
+	orderBy  []ColumnDesc
+
+	// conditions are the filters of the selected conditional
+	// aggregates, like count_if(...), by column.
+	conditions [][]Filter
+
+	// nullsAsZero is set for the selected aggregates that add nil and
+	// missing values as 0.
+	nullsAsZero []bool
+
+	groups map[string]*group
+}
+
+// A group holds its GROUP BY values and an Aggregator for each
+// selected aggregate. present[i] is false if none of its rows had
+// column i.
+type group struct {
+	values     []interface{}
+	present    []bool
+	aggregates []Aggregator
+}
+
+func (e *Executor) newGrouper(query *Query) (*grouper, error) {
+	g := &grouper{
+		columns:     query.GroupBy,
+		selected:    query.Columns,
+		orderBy:     query.OrderBy,
+		conditions:  make([][]Filter, len(query.Columns)),
+		nullsAsZero: make([]bool, len(query.Columns)),
+		groups:      map[string]*group{},
+	}
+	for i, c := range query.Columns {
+		g.nullsAsZero[i] = c.Aggregate != "" && e.nullPolicies[c.Aggregate] == NullsAsZero
+		if len(c.Filters) == 0 {
+			continue
+		}
+		filters, err := e.buildFilters(c.Filters)
+		if err != nil {
+			return nil, err
+		}
+		g.conditions[i] = filters
+	}
+	if len(g.columns) == 0 {
+		// Without a GROUP BY, every row is in one group, which exists
+		// even if no rows match.
+		g.groups[""] = g.newGroup()
+	}
+	return g, nil
+}
+
+func (g *grouper) newGroup() *group {
+	grp := &group{
+		values:     make([]interface{}, len(g.columns)),
+		present:    make([]bool, len(g.columns)),
+		aggregates: make([]Aggregator, len(g.selected)),
+	}
+	for i, c := range g.selected {
+		if c.Aggregate != "" {
+			grp.aggregates[i] = aggregates[c.Aggregate]()
+		}
+	}
+	return grp
+}
+
+// add adds a row to its group. Rows without a GROUP BY column are
+// grouped with rows where it's nil.
+func (g *grouper) add(row Row) error {
+	key := ""
+	if len(g.columns) > 0 {
+		key = distinctKey(row, g.columns)
+	}
+	grp, ok := g.groups[key]
+	if !ok {
+		grp = g.newGroup()
+		g.groups[key] = grp
+	}
+	for i, c := range g.columns {
+		if c.Function != "" {
+			grp.values[i] = columnValue(c, row)
+			grp.present[i] = true
+		} else if v, ok := row.Get(c.Name); ok {
+			grp.values[i] = v
+			grp.present[i] = true
+		}
+	}
+
+Columns:
+	for i, c := range g.selected {
+		if c.Aggregate == "" {
+			continue
+		}
+		ok, err := passes(g.conditions[i], row)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue Columns
+		}
+		values := []interface{}{}
+		if c.Name != "*" && c.Name != "" {
+			for _, name := range append([]string{c.Name}, c.Arguments...) {
+				v, ok := row.Get(name)
+				if !ok || v == nil {
+					if !g.nullsAsZero[i] {
+						continue Columns
+					}
+					v = 0
+				}
+				values = append(values, v)
+			}
+		}
+		if err := grp.aggregates[i].Add(values...); err != nil {
+			return fmt.Errorf("query: %s: %v", columnName(c), err)
+		}
+	}
+	return nil
+}
+
+// rows returns a row for each group with the selected columns, sorted
+// by the GROUP BY values, and the values of its ORDER BY columns. A "*"
+// selects every GROUP BY column.
+func (g *grouper) rows() ([]resultRow, [][]interface{}) {
+	groups := []*group{}
+	for _, grp := range g.groups {
+		groups = append(groups, grp)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return compareTuples(groups[i].values, groups[j].values) < 0
+	})
+
+	rows := []resultRow{}
+	keys := [][]interface{}{}
+	for _, grp := range groups {
+		row := newRow()
+		for i, c := range g.selected {
+			if c.Aggregate != "" {
+				row.set(columnName(c), grp.aggregates[i].Result())
+				continue
+			}
+			for j, groupColumn := range g.columns {
+				if !grp.present[j] {
+					continue
+				}
+				if c.Name == "*" {
+					row.set(columnName(groupColumn), grp.values[j])
+				} else if formatColumn(c) == formatColumn(groupColumn) {
+					row.set(columnName(c), grp.values[j])
+				}
+			}
+		}
+		rows = append(rows, row)
+
+		key := []interface{}{}
+		for _, c := range g.orderBy {
+			key = append(key, g.value(grp, c))
+		}
+		keys = append(keys, key)
+	}
+	return rows, keys
+}
+
+// value returns the group's value of a GROUP BY column, or of a
+// function of one, or of a selected aggregate.
+func (g *grouper) value(grp *group, c ColumnDesc) interface{} {
+	if c.Aggregate != "" {
+		for i, selected := range g.selected {
+			if formatColumn(selected) == formatColumn(c) {
+				return grp.aggregates[i].Result()
+			}
+		}
+		return nil
+	}
+	for i, groupColumn := range g.columns {
+		if formatColumn(groupColumn) == formatColumn(c) {
+			return grp.values[i]
+		}
+	}
+	for i, groupColumn := range g.columns {
+		if groupColumn.Function == "" && groupColumn.Name == c.Name {
+			return applyFunction(c, grp.values[i])
+		}
+	}
+	return nil
+}
+
+// Grouped returns the rows of a GROUP BY query nested by their GROUP BY
+// values, in the order of the GROUP BY columns. With GROUP BY region,
+// product, it's a map from each region to a map from each product to
+// the group's leaf, which maps the group's other columns, like its
+// aggregates, to their values. With a single GROUP BY column there's a
+// level of maps for it and then the leaves.
+//
+// Keys are the GROUP BY values formatted like fmt.Sprint, except that
+// nils and missing values are "null". Every GROUP BY column has to be
+// selected, and Grouped returns an error if the query has no GROUP BY or
+// if two groups' values format the same, like 1 and "1".
+func (res *Result) Grouped() (map[string]interface{}, error) {
+	if len(res.groupBy) == 0 {
+		return nil, fmt.Errorf("query: the result isn't grouped")
+	}
+	// names are the fields of the GROUP BY columns in the rows.
+	names := make([]string, len(res.groupBy))
+	for i, groupColumn := range res.groupBy {
+		for _, c := range res.columns {
+			if c.Name == "*" && c.Aggregate == "" {
+				names[i] = columnName(groupColumn)
+			} else if c.Aggregate == "" && formatColumn(c) == formatColumn(groupColumn) {
+				names[i] = columnName(c)
+				break
+			}
+		}
+		if names[i] == "" {
+			return nil, fmt.Errorf("query: GROUP BY column %q must be selected to nest the result", formatColumn(groupColumn))
+		}
+	}
+	grouping := map[string]bool{}
+	for _, name := range names {
+		grouping[name] = true
+	}
+
+	nested := map[string]interface{}{}
+	for _, row := range res.rows {
+		level := nested
+		for i, name := range names {
+			key := "null"
+			if v := row.values[name]; v != nil {
+				key = fmt.Sprint(v)
+			}
+			if i == len(names)-1 {
+				if _, ok := level[key]; ok {
+					return nil, fmt.Errorf("query: two groups have the key %q", key)
+				}
+				leaf := map[string]interface{}{}
+				for _, field := range row.Fields() {
+					if !grouping[field] {
+						leaf[field] = row.values[field]
+					}
+				}
+				level[key] = leaf
+				break
+			}
+			next, ok := level[key].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				level[key] = next
+			}
+			level = next
+		}
+	}
+	return nested, nil
+}
+
+// compareTuples compares a and b element by element with
+// compareValues.
+func compareTuples(a, b []interface{}) int {
+	for i := range a {
+		if c := compareValues(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareValues orders any two values for sorting. Values that
+// compareInterfaces can compare are in that order. Otherwise nil comes
+// first, then bools, numbers, strings, Versions, and other values, and
+// other values of the same kind are ordered by how they're formatted.
+func compareValues(a, b interface{}) int {
+	if c, ok := compareInterfaces(a, b); ok {
+		return c
+	}
+	if rankA, rankB := sortRank(a), sortRank(b); rankA != rankB {
+		return compareInts(int64(rankA), int64(rankB))
+	}
+	return strings.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+func sortRank(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case string:
+		return 3
+	case Version:
+		return 4
+	}
+	if _, ok := toFloat64(v); ok {
+		return 2
+	}
+	return 5
+}
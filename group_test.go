@@ -0,0 +1,243 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testGroups = testSliceTable{
+	{"id": 1, "region": "us", "kind": "a"},
+	{"id": 2, "region": "eu", "kind": "b"},
+	{"id": 3, "region": "us", "kind": "b"},
+	{"id": 4, "kind": "a"},
+	{"id": 5, "region": "eu", "kind": "b"},
+	{"id": 6, "region": "us", "kind": "a"},
+	{"id": 7, "region": nil, "kind": "a"},
+	{"id": 8, "kind": "c"},
+}
+
+func executeRows(t *testing.T, table Table, query string) []map[string]interface{} {
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatal(query, err)
+	}
+	res, err := NewExecutor(table).Execute(q)
+	if err != nil {
+		t.Fatal(query, err)
+	}
+	rows := []map[string]interface{}{}
+	for _, row := range res.Rows() {
+		rows = append(rows, row.(resultRow).values)
+	}
+	return rows
+}
+
+func TestGroupBy(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		// Rows without region are grouped with the nil region.
+		{"SELECT region GROUP BY region", []map[string]interface{}{
+			{"region": nil},
+			{"region": "eu"},
+			{"region": "us"},
+		}},
+		{`SELECT * WHERE kind = "a" GROUP BY region, kind`, []map[string]interface{}{
+			{"region": nil, "kind": "a"},
+			{"region": "us", "kind": "a"},
+		}},
+		// A group only has a column if one of its rows does.
+		{"SELECT kind, region GROUP BY region, kind", []map[string]interface{}{
+			{"region": nil, "kind": "a"},
+			{"kind": "c"},
+			{"region": "eu", "kind": "b"},
+			{"region": "us", "kind": "a"},
+			{"region": "us", "kind": "b"},
+		}},
+		{"SELECT kind GROUP BY region, kind LIMIT 2", []map[string]interface{}{
+			{"kind": "a"},
+			{"kind": "c"},
+		}},
+	}
+	sharded := testShardedTable{shards: []testSliceTable{testGroups[:2], testGroups[2:5], testGroups[5:7], testGroups[7:]}}
+	for _, c := range cases {
+		for _, table := range []Table{testGroups, sharded} {
+			if rows := executeRows(t, table, c.query); !reflect.DeepEqual(rows, c.expected) {
+				t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+			}
+		}
+	}
+}
+
+func TestGroupOrder(t *testing.T) {
+	// Groups come sorted by their GROUP BY values rather than in the
+	// order of a map, run after run and however the table is sharded.
+	sharded := testShardedTable{shards: []testSliceTable{testGroups[:3], testGroups[3:5], testGroups[5:]}}
+	mixed := testSliceTable{{"v": 2}, {"v": "x"}, {"v": 1.5}, {"v": true}, {"v": nil}, {"v": "a"}}
+	cases := []struct {
+		table    Table
+		query    string
+		expected []map[string]interface{}
+	}{
+		{testGroups, "SELECT region, kind, count(*) GROUP BY region, kind", []map[string]interface{}{
+			{"region": nil, "kind": "a", "count(*)": 2},
+			// No row of the group has a region.
+			{"kind": "c", "count(*)": 1},
+			{"region": "eu", "kind": "b", "count(*)": 2},
+			{"region": "us", "kind": "a", "count(*)": 2},
+			{"region": "us", "kind": "b", "count(*)": 1},
+		}},
+		{sharded, "SELECT kind, region, count(*) GROUP BY kind, region", []map[string]interface{}{
+			{"kind": "a", "region": nil, "count(*)": 2},
+			{"kind": "a", "region": "us", "count(*)": 2},
+			{"kind": "b", "region": "eu", "count(*)": 2},
+			{"kind": "b", "region": "us", "count(*)": 1},
+			{"kind": "c", "count(*)": 1},
+		}},
+		// Values of different kinds are ordered like ORDER BY orders
+		// them.
+		{mixed, "SELECT v GROUP BY v", []map[string]interface{}{
+			{"v": nil}, {"v": true}, {"v": 1.5}, {"v": 2}, {"v": "a"}, {"v": "x"},
+		}},
+	}
+	for _, c := range cases {
+		for i := 0; i < 10; i++ {
+			if rows := executeRows(t, c.table, c.query); !reflect.DeepEqual(rows, c.expected) {
+				t.Fatalf("%s: expected %v, got %v", c.query, c.expected, rows)
+			}
+		}
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	sorted := []interface{}{nil, false, true, -1.5, 1, int64(2), 2.5, "", "a", Version{Major: 1}, []int{1}, []int{2}}
+	for i := range sorted {
+		for j := range sorted {
+			expected := compareInts(int64(i), int64(j))
+			if c := compareValues(sorted[i], sorted[j]); c != expected {
+				t.Errorf("compareValues(%#v, %#v): expected %d, got %d", sorted[i], sorted[j], expected, c)
+			}
+		}
+	}
+}
+
+func TestAliases(t *testing.T) {
+	rows := executeRows(t, testGroups, `SELECT region AS r, count(id) AS total GROUP BY region ORDER BY count(id) DESC LIMIT 1`)
+	if len(rows) != 1 || len(rows[0]) != 2 {
+		t.Fatalf("expected one row with two fields, got %v", rows)
+	}
+	if _, ok := rows[0]["total"]; !ok {
+		t.Errorf("expected a total field, got %v", rows[0])
+	}
+	if _, ok := rows[0]["r"]; !ok {
+		t.Errorf("expected an r field, got %v", rows[0])
+	}
+
+	rows = executeRows(t, testGroups, `SELECT id AS identifier WHERE id = 1`)
+	if !reflect.DeepEqual(rows, []map[string]interface{}{{"identifier": 1}}) {
+		t.Errorf("expected the id as identifier, got %v", rows)
+	}
+
+	rows = executeRows(t, testGroups, `SELECT kind, count(*) AS n GROUP BY kind`)
+	expected := []map[string]interface{}{
+		{"kind": "a", "n": 4},
+		{"kind": "b", "n": 3},
+		{"kind": "c", "n": 1},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v, got %v", expected, rows)
+	}
+
+	// ORDER BY may name an alias.
+	rows = executeRows(t, testGroups, `SELECT kind, count(*) AS n GROUP BY kind ORDER BY n`)
+	expected = []map[string]interface{}{
+		{"kind": "c", "n": 1},
+		{"kind": "b", "n": 3},
+		{"kind": "a", "n": 4},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v, got %v", expected, rows)
+	}
+	ids := executeIDs(t, testSliceTable{{"id": 3}, {"id": 1}, {"id": 2}}, `SELECT id AS x, id ORDER BY x`)
+	if !reflect.DeepEqual(ids, []interface{}{1, 2, 3}) {
+		t.Errorf("expected ids sorted by their alias, got %v", ids)
+	}
+
+	// An explicit column wins over a field of * with the same name.
+	rows = executeRows(t, testSliceTable{{"id": 3, "a": "x"}}, `SELECT a AS id, *`)
+	if !reflect.DeepEqual(rows, []map[string]interface{}{{"id": "x", "a": "x"}}) {
+		t.Errorf("expected a as id, got %v", rows)
+	}
+}
+
+func TestGroupedResult(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected map[string]interface{}
+	}{
+		{"SELECT region, kind, count(*) GROUP BY region, kind", map[string]interface{}{
+			"null": map[string]interface{}{
+				"a": map[string]interface{}{"count(*)": 2},
+				"c": map[string]interface{}{"count(*)": 1},
+			},
+			"eu": map[string]interface{}{
+				"b": map[string]interface{}{"count(*)": 2},
+			},
+			"us": map[string]interface{}{
+				"a": map[string]interface{}{"count(*)": 2},
+				"b": map[string]interface{}{"count(*)": 1},
+			},
+		}},
+		{"SELECT kind AS k, count(*), min(id) GROUP BY kind", map[string]interface{}{
+			"a": map[string]interface{}{"count(*)": 4, "min(id)": 1},
+			"b": map[string]interface{}{"count(*)": 3, "min(id)": 2},
+			"c": map[string]interface{}{"count(*)": 1, "min(id)": 8},
+		}},
+		{"SELECT * GROUP BY kind", map[string]interface{}{
+			"a": map[string]interface{}{},
+			"b": map[string]interface{}{},
+			"c": map[string]interface{}{},
+		}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(testGroups).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		grouped, err := res.Grouped()
+		if err != nil {
+			t.Errorf("%s: %v", c.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(grouped, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, grouped)
+		}
+	}
+
+	numbers := testSliceTable{{"id": 1, "n": 1}, {"id": 2, "n": "1"}}
+	for _, c := range []struct {
+		table Table
+		query string
+	}{
+		{testGroups, "SELECT count(*)"},
+		{testGroups, "SELECT count(*) GROUP BY kind"},
+		{numbers, "SELECT n, count(*) GROUP BY n"},
+	} {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := NewExecutor(c.table).Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		if grouped, err := res.Grouped(); err == nil {
+			t.Errorf("%s: expected an error, got %v", c.query, grouped)
+		}
+	}
+}
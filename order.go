@@ -0,0 +1,77 @@
+package query
+
+import "sort"
+
+// sortKeys returns the values of the ORDER BY columns of each row,
+// with their functions applied.
+func sortKeys(rows []resultRow, orderBy []ColumnDesc) [][]interface{} {
+	keys := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		key := make([]interface{}, len(orderBy))
+		for j, c := range orderBy {
+			key[j] = columnValue(c, row)
+		}
+		keys[i] = key
+	}
+	return keys
+}
+
+// sortRows sorts rows and their keys by the keys with compareValues,
+// keeping the order of rows with equal keys. Strings are compared by
+// the executor's collation, if it has one.
+func (e *Executor) sortRows(rows []resultRow, keys [][]interface{}, descending bool) {
+	collated := keys
+	if e.collate != nil {
+		collated = make([][]interface{}, len(keys))
+		for i, key := range keys {
+			collated[i] = e.collateKey(key)
+		}
+	}
+
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		c := compareTuples(collated[order[i]], collated[order[j]])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	sortedRows := make([]resultRow, len(rows))
+	sortedKeys := make([][]interface{}, len(keys))
+	for i, j := range order {
+		sortedRows[i] = rows[j]
+		sortedKeys[i] = keys[j]
+	}
+	copy(rows, sortedRows)
+	copy(keys, sortedKeys)
+}
+
+// compareKeys compares two sort keys like sortRows, returning a
+// negative number if a sorts before b.
+func (e *Executor) compareKeys(a, b []interface{}, descending bool) int {
+	c := compareTuples(e.collateKey(a), e.collateKey(b))
+	if descending {
+		return -c
+	}
+	return c
+}
+
+// collateKey returns key with its strings replaced by their collation
+// keys, if the executor has a collation.
+func (e *Executor) collateKey(key []interface{}) []interface{} {
+	if e.collate == nil {
+		return key
+	}
+	collated := make([]interface{}, len(key))
+	for i, v := range key {
+		if s, ok := v.(string); ok {
+			v = e.collate(s)
+		}
+		collated[i] = v
+	}
+	return collated
+}
@@ -0,0 +1,210 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderBy(t *testing.T) {
+	ties := testSliceTable{
+		{"id": 1, "n": 2},
+		{"id": 2, "n": 1},
+		{"id": 3, "n": 2},
+		{"id": 4},
+		{"id": 5, "n": 1.5},
+		{"id": 6, "n": 1},
+	}
+	cases := []struct {
+		table    Table
+		query    string
+		expected []interface{}
+	}{
+		{testDataTable{}, "SELECT * ORDER BY id DESC", []interface{}{4, 3, 2, 1}},
+		// Strings compare byte by byte, so upper case comes first.
+		{testNames, "SELECT * ORDER BY name", []interface{}{4, 1, 2, 3}},
+		{testNames, "SELECT * ORDER BY name DESC LIMIT 2", []interface{}{3, 2}},
+		{testNames, `SELECT * WHERE name starts_with "J" ORDER BY name DESC`, []interface{}{2, 1}},
+		// Rows with equal values keep their order, and missing values
+		// come first.
+		{ties, "SELECT * ORDER BY n", []interface{}{4, 2, 6, 5, 1, 3}},
+		{ties, "SELECT * ORDER BY n DESC", []interface{}{1, 3, 5, 2, 6, 4}},
+		{ties, "SELECT DISTINCT ON (n) * ORDER BY n, id DESC", []interface{}{3, 5, 6, 4}},
+	}
+	for _, c := range cases {
+		if ids := executeIDs(t, c.table, c.query); !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+}
+
+func TestOrderByFunctions(t *testing.T) {
+	letters := testSliceTable{
+		{"id": 1, "name": "b", "n": -3},
+		{"id": 2, "name": "A", "n": 2},
+		{"id": 3, "name": "c", "n": int64(-1)},
+		{"id": 4, "name": "B", "n": 1.5},
+		{"id": 5, "n": "x"},
+	}
+	cases := []struct {
+		table    Table
+		query    string
+		expected []interface{}
+	}{
+		{testNames, "SELECT * ORDER BY len(name)", []interface{}{1, 3, 4, 2}},
+		{testNames, "SELECT * ORDER BY len(name) DESC", []interface{}{2, 3, 4, 1}},
+		// Values a function doesn't apply to sort like nulls.
+		{letters, "SELECT * ORDER BY lower(name)", []interface{}{5, 2, 1, 4, 3}},
+		{letters, "SELECT * ORDER BY abs(n)", []interface{}{5, 3, 4, 2, 1}},
+		{letters, "SELECT id ORDER BY ABS(n) DESC LIMIT 2", []interface{}{1, 2}},
+	}
+	for _, c := range cases {
+		if ids := executeIDs(t, c.table, c.query); !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+
+	rows := executeRows(t, testGroups, "SELECT region, count(*) GROUP BY region ORDER BY lower(region) DESC")
+	regions := []interface{}{}
+	for _, row := range rows {
+		regions = append(regions, row["region"])
+	}
+	if expected := []interface{}{"us", "eu", nil}; !reflect.DeepEqual(regions, expected) {
+		t.Errorf("expected regions %v, got %v", expected, regions)
+	}
+
+	for _, query := range []string{
+		"SELECT DISTINCT ON (len(name)) *",
+		"SELECT * ORDER BY len(*)",
+		"SELECT * ORDER BY abs(a, b)",
+		"SELECT region, count(*) GROUP BY region ORDER BY len(kind)",
+	} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if _, err := NewExecutor(testGroups).Execute(q); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestDefaultOrderDescending(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"SELECT * ORDER BY id", []interface{}{4, 3, 2, 1}},
+		{"SELECT * ORDER BY id DESC", []interface{}{4, 3, 2, 1}},
+		{"SELECT * ORDER BY id ASC", []interface{}{1, 2, 3, 4}},
+		{"SELECT * ORDER BY id ASC LIMIT 2", []interface{}{1, 2}},
+	}
+	for _, c := range cases {
+		if ids := executeIDs(t, testNames, c.query, WithDefaultOrderDescending()); !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+
+	// ASC is the default without the option.
+	if ids := executeIDs(t, testNames, "SELECT * ORDER BY id ASC"); !reflect.DeepEqual(ids, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("expected ascending ids, got %v", ids)
+	}
+	q, err := Parse("SELECT * ORDER BY id ASC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Ascending || q.SQL() != "SELECT * ORDER BY id ASC" {
+		t.Errorf("expected an explicit ASC, got %v", q.SQL())
+	}
+}
+
+func TestOrderByGroups(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected []map[string]interface{}
+	}{
+		{"SELECT kind, count(*) GROUP BY kind ORDER BY count(*) DESC", []map[string]interface{}{
+			{"kind": "a", "count(*)": 4},
+			{"kind": "b", "count(*)": 3},
+			{"kind": "c", "count(*)": 1},
+		}},
+		// Groups with equal values stay sorted by their GROUP BY
+		// values.
+		{"SELECT count(*) GROUP BY region, kind ORDER BY region DESC LIMIT 3", []map[string]interface{}{
+			{"count(*)": 2},
+			{"count(*)": 1},
+			{"count(*)": 2},
+		}},
+	}
+	for _, c := range cases {
+		if rows := executeRows(t, testGroups, c.query); !reflect.DeepEqual(rows, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, rows)
+		}
+	}
+
+	q, err := Parse("SELECT kind GROUP BY kind ORDER BY count(*)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(testGroups).Execute(q); err == nil {
+		t.Error("expected an error for an ORDER BY aggregate that isn't selected")
+	}
+}
+
+func TestOrderByCollation(t *testing.T) {
+	cases := []struct {
+		table    Table
+		expected []interface{}
+	}{
+		{testNames, []interface{}{4, 1, 2, 3}},
+		{testSliceTable{{"id": 1, "name": "b"}, {"id": 2, "name": "A"}, {"id": 3, "name": "a"}, {"id": 4, "name": "B"}}, []interface{}{2, 3, 1, 4}},
+	}
+	q, err := Parse("SELECT * ORDER BY name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cases {
+		res, err := NewExecutor(c.table, WithCollation("en", CaseInsensitive)).Execute(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, ids)
+		}
+	}
+}
+
+func TestOrderByPages(t *testing.T) {
+	q, err := Parse("SELECT * WHERE id != 5 ORDER BY id DESC LIMIT 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewExecutor(newTestShardedTable(3, 3))
+
+	pages := [][]interface{}{}
+	token := ""
+	for len(pages) < 10 {
+		res, next, err := e.ExecutePage(q, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		pages = append(pages, ids)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	expected := [][]interface{}{{9, 8, 7}, {6, 4, 3}, {2, 1}}
+	if !reflect.DeepEqual(pages, expected) {
+		t.Errorf("expected %v, got %v", expected, pages)
+	}
+}
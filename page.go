@@ -0,0 +1,99 @@
+package query
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+)
+
+// ErrInvalidPageToken is returned by ExecutePage for a token that
+// wasn't returned by a previous call for the same query.
+var ErrInvalidPageToken = errors.New("query: invalid page token")
+
+// A pageToken is the position of a page. Pages of ORDER BY queries
+// start after the rows sorting before After and the first Skip rows
+// with the same values as After, and other pages start at Offset.
+type pageToken struct {
+	Offset int           `json:"offset,omitempty"`
+	After  []interface{} `json:"after,omitempty"`
+	Skip   int           `json:"skip,omitempty"`
+	Query  uint32        `json:"query"`
+}
+
+// ExecutePage executes a query one page at a time. The page size is the
+// query's limit, which is required. The first page is returned for an
+// empty token, and each page comes with the token for the next one, or
+// an empty token after the last page. The table is read in order, even
+// if it's a ShardedTable.
+//
+// A page of an ORDER BY query starts after the ORDER BY values of the
+// last row of the previous page, so rows added to or removed from the
+// table between calls don't shift the pages, unless they have the same
+// values as that row. The values are kept in the token as JSON, so they
+// should be numbers, strings, bools or nulls. Pages of other queries
+// are offsets into the result and may shift.
+func (e *Executor) ExecutePage(query *Query, token string) (*Result, string, error) {
+	if e.limit(query) == 0 {
+		return nil, "", ErrLimitRequired
+	}
+
+	start := pageToken{}
+	if token != "" {
+		t, err := decodePageToken(token)
+		if err != nil || t.Query != queryHash(query) || t.Offset < 0 || t.Skip < 0 ||
+			t.After != nil && len(t.After) != len(query.OrderBy) {
+			return nil, "", ErrInvalidPageToken
+		}
+		start = t
+	}
+
+	res, next, err := e.execute(query, &start, 0, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if next == nil {
+		return res, "", nil
+	}
+	next.Query = queryHash(query)
+	return res, encodePageToken(*next), nil
+}
+
+// queryHash identifies a query so that a page token can't be used for
+// a different query.
+func queryHash(query *Query) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(query.Pretty()))
+	return h.Sum32()
+}
+
+func encodePageToken(t pageToken) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string) (pageToken, error) {
+	t := pageToken{}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&t); err != nil {
+		return t, err
+	}
+	// Numbers are decoded as int64s if they fit so that large integers
+	// compare exactly.
+	for i, v := range t.After {
+		if n, ok := v.(json.Number); ok {
+			if x, err := n.Int64(); err == nil {
+				t.After[i] = x
+			} else if x, err := n.Float64(); err == nil {
+				t.After[i] = x
+			}
+		}
+	}
+	return t, nil
+}
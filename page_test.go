@@ -0,0 +1,170 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExecutePage(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected [][]interface{}
+	}{
+		{"SELECT * WHERE id != 5 LIMIT 3", [][]interface{}{{1, 2, 3}, {4, 6, 7}, {8, 9}}},
+		{"SELECT * WHERE id != 5 LIMIT 3 OFFSET 2", [][]interface{}{{3, 4, 6}, {7, 8, 9}}},
+	}
+	e := NewExecutor(newTestShardedTable(3, 3))
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages := [][]interface{}{}
+		token := ""
+		for {
+			res, next, err := e.ExecutePage(q, token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := []interface{}{}
+			for _, row := range res.Rows() {
+				id, _ := row.Get("id")
+				ids = append(ids, id)
+			}
+			pages = append(pages, ids)
+			if next == "" {
+				break
+			}
+			if len(pages) > 10 {
+				t.Fatal("too many pages")
+			}
+			token = next
+		}
+
+		if !reflect.DeepEqual(pages, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, pages)
+		}
+	}
+}
+
+func TestExecutePageErrors(t *testing.T) {
+	e := NewExecutor(testNames)
+	q, err := Parse("SELECT * LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, token, err := e.ExecutePage(q, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := Parse("SELECT * WHERE id > 1 LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.ExecutePage(other, token); err != ErrInvalidPageToken {
+		t.Errorf("expected %v for another query's token, got %v", ErrInvalidPageToken, err)
+	}
+	if _, _, err := e.ExecutePage(q, "not a token"); err != ErrInvalidPageToken {
+		t.Errorf("expected %v for a bad token, got %v", ErrInvalidPageToken, err)
+	}
+
+	ordered, err := Parse("SELECT * ORDER BY id LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongKey := encodePageToken(pageToken{After: []interface{}{1, 2}, Query: queryHash(ordered)})
+	if _, _, err := e.ExecutePage(ordered, wrongKey); err != ErrInvalidPageToken {
+		t.Errorf("expected %v for a token with the wrong ORDER BY values, got %v", ErrInvalidPageToken, err)
+	}
+
+	unlimited, err := Parse("SELECT *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.ExecutePage(unlimited, ""); err != ErrLimitRequired {
+		t.Errorf("expected %v, got %v", ErrLimitRequired, err)
+	}
+	if _, _, err := NewExecutor(testNames, WithDefaultLimit(3)).ExecutePage(unlimited, ""); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExecutePageOrderedInserts(t *testing.T) {
+	table := &testSliceTable{
+		{"id": 1, "score": 10},
+		{"id": 2, "score": 20},
+		{"id": 3, "score": 20},
+		{"id": 4, "score": 30},
+		{"id": 5, "score": int64(1) << 60},
+	}
+	q, err := Parse("SELECT id ORDER BY score LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewExecutor(table)
+
+	pages := [][]interface{}{}
+	token := ""
+	for len(pages) < 10 {
+		res, next, err := e.ExecutePage(q, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		pages = append(pages, ids)
+		if next == "" {
+			break
+		}
+		token = next
+
+		// Rows added before the next page's position aren't returned,
+		// and rows added after it are.
+		if len(pages) == 1 {
+			*table = append(*table,
+				map[string]interface{}{"id": 6, "score": 5},
+				map[string]interface{}{"id": 7, "score": 20},
+				map[string]interface{}{"id": 8, "score": int64(1)<<60 + 1},
+			)
+		}
+	}
+	expected := [][]interface{}{{1, 2}, {3, 7}, {4, 5}, {8}}
+	if !reflect.DeepEqual(pages, expected) {
+		t.Errorf("expected %v, got %v", expected, pages)
+	}
+}
+
+func TestExecutePageOrderedGroups(t *testing.T) {
+	q, err := Parse("SELECT kind, count(*) GROUP BY kind ORDER BY kind DESC LIMIT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewExecutor(testGroups)
+
+	kinds := []interface{}{}
+	token := ""
+	for len(kinds) < 10 {
+		res, next, err := e.ExecutePage(q, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range res.Rows() {
+			kind, _ := row.Get("kind")
+			kinds = append(kinds, kind)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	expected := []interface{}{"c", "b", "a"}
+	if !reflect.DeepEqual(kinds, expected) {
+		t.Errorf("expected %v, got %v", expected, kinds)
+	}
+}
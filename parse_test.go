@@ -1,19 +1,32 @@
 package query
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
 
-func TestParser(t *testing.T) {
-	validQueries := []string{
-		"SELECT *",
-		"SELECT * WHERE foo = 1",
-		"SELECT * WHERE foo = 1, bar = 2",
-		"SELECT * WHERE foo = 1, bar = 2 GROUP BY foo",
-		"SELECT * WHERE foo = 1, bar = 2 GROUP BY foo ORDER BY bar",
-		"SELECT * WHERE foo = 1, bar = 2 ORDER BY foo",
-		"SELECT * WHERE foo = 1, bar = 2 LIMIT 10",
-		"SELECT * WHERE foo = 1, bar = 2 ORDER BY foo DESC",
-	}
+var validQueries = []string{
+	"SELECT *",
+	"SELECT * WHERE foo = 1",
+	"SELECT * WHERE foo = 1, bar = 2",
+	"SELECT * WHERE foo = 1, bar = 2 GROUP BY foo",
+	"SELECT * WHERE foo = 1, bar = 2 GROUP BY foo ORDER BY bar",
+	"SELECT * WHERE foo = 1, bar = 2 ORDER BY foo",
+	"SELECT * WHERE foo = 1, bar = 2 LIMIT 10",
+	"SELECT * WHERE foo = 1, bar = 2 ORDER BY foo DESC",
+	`SELECT * WHERE name starts_with "jo", name ends_with "son"`,
+	`SELECT * WHERE name istarts_with "JO" LIMIT 5`,
+	`SELECT a, count_if((b > 2)) GROUP BY a`,
+	`SELECT COUNT_IF(b matches "x")`,
+	"SELECT * WHERE len(tags) > 3, len ( name ) = 0",
+}
 
+func TestParser(t *testing.T) {
 	for _, q := range validQueries {
 		_, err := Parse(q)
 		if err != nil {
@@ -27,3 +40,1001 @@ func BenchmarkParser(b *testing.B) {
 		Parse("SELECT a, b, min(c), sum(d) WHERE a < 1, b < 2, c < 3 GROUP BY a, b ORDER BY min(c) DESC LIMIT 10")
 	}
 }
+
+func BenchmarkParseShort(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		Parse("SELECT *")
+	}
+}
+
+func BenchmarkParserReuse(b *testing.B) {
+	query := "SELECT a, b, min(c), sum(d) WHERE a < 1, b < 2, c < 3 GROUP BY a, b ORDER BY min(c) DESC LIMIT 10"
+	b.Run("Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			Parse(query)
+		}
+	})
+	b.Run("Parser", func(b *testing.B) {
+		b.ReportAllocs()
+		p := NewParser()
+		for n := 0; n < b.N; n++ {
+			p.Parse(query)
+		}
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add("SELECT *")
+	f.Add("SELECT * WHERE foo = 1, bar = 2 ORDER BY foo DESC")
+	f.Add(`SELECT a, min(b) WHERE c matches "^x\\d+$" GROUP BY a LIMIT 10`)
+	f.Add("SELECT * WHERE ((foo = 1.5e3))")
+
+	f.Fuzz(func(t *testing.T, query string) {
+		q, err := Parse(query)
+		if (q == nil) == (err == nil) {
+			t.Fatalf("Parse(%q) = %v, %v", query, q, err)
+		}
+	})
+}
+
+func TestParseConditionalAggregate(t *testing.T) {
+	q, err := Parse(`SELECT region, count_if(status = "error") WHERE id > 5 GROUP BY region`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(q.Columns) != 2 {
+		t.Fatalf("expected 2 columns, got %v", q.Columns)
+	}
+	c := q.Columns[1]
+	if c.Aggregate != "count_if" || c.Name != "" {
+		t.Errorf("unexpected column %+v", c)
+	}
+	if !reflect.DeepEqual(c.Filters, []FilterDesc{{Column: "status", Operator: "=", Value: "error"}}) {
+		t.Errorf("unexpected condition %+v", c.Filters)
+	}
+	if len(q.Filters) != 1 || q.Filters[0].Column != "id" {
+		t.Errorf("unexpected filters %+v", q.Filters)
+	}
+	if len(q.GroupBy) != 1 || q.GroupBy[0].Name != "region" {
+		t.Errorf("unexpected group by %+v", q.GroupBy)
+	}
+
+	// A condition belongs to the count_if of the clause it's in.
+	q, err = Parse(`SELECT a, count_if(b = "y") GROUP BY a ORDER BY count_if(b = "y")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	condition := []FilterDesc{{Column: "b", Operator: "=", Value: "y"}}
+	if !reflect.DeepEqual(q.Columns[1].Filters, condition) {
+		t.Errorf("unexpected SELECT condition %+v", q.Columns[1].Filters)
+	}
+	if len(q.OrderBy) != 1 || !reflect.DeepEqual(q.OrderBy[0].Filters, condition) {
+		t.Errorf("unexpected ORDER BY %+v", q.OrderBy)
+	}
+}
+
+func TestParseNumericValues(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected interface{}
+	}{
+		{"SELECT * WHERE flags = 255", 255},
+		{"SELECT * WHERE flags = 010", 10},
+		{"SELECT * WHERE flags = -42", -42},
+		{"SELECT * WHERE flags = 0xFF", 255},
+		{"SELECT * WHERE flags = 0Xff", 255},
+		{"SELECT * WHERE flags = -0x10", -16},
+		{"SELECT * WHERE flags = 0b1010", 10},
+		{"SELECT * WHERE flags = 0B11", 3},
+		{"SELECT * WHERE flags = 0o17", 15},
+		{"SELECT * WHERE flags = +0o7", 7},
+		{"SELECT * WHERE flags = 1.5", 1.5},
+		{"SELECT * WHERE flags = 1e3", 1000.0},
+		{"SELECT * WHERE flags = -2.5E-1", -0.25},
+	}
+
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Error(c.query, err)
+			continue
+		}
+		if v := q.Filters[0].Value; v != c.expected {
+			t.Errorf("%s: expected %#v, got %#v", c.query, c.expected, v)
+		}
+	}
+
+	for _, query := range []string{
+		"SELECT * WHERE flags = 0x",
+		"SELECT * WHERE flags = 0b102",
+		"SELECT * WHERE flags = 0o8",
+		"SELECT * WHERE flags = 0xFFFFFFFFFFFFFFFFFF",
+		"SELECT * WHERE flags = 99999999999999999999",
+		"SELECT * WHERE flags IN (1, -9223372036854775809)",
+	} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected a parse error", query)
+		}
+	}
+	if q, err := Parse("SELECT * WHERE flags = -0x8000000000000000"); err != nil || q.Filters[0].Value != math.MinInt64 {
+		t.Errorf("expected the smallest integer, got %v, %v", q, err)
+	}
+}
+
+func TestParseWithParams(t *testing.T) {
+	params := map[string]interface{}{
+		"uid":    "u-123",
+		"min":    5,
+		"unused": true,
+	}
+	q, err := ParseWithParams("SELECT * WHERE user = :uid, score >= :min, other = :uid", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{"u-123", 5, "u-123"}
+	for i, f := range q.Filters {
+		if f.Value != expected[i] {
+			t.Errorf("filter %d: expected %v, got %v", i, expected[i], f.Value)
+		}
+	}
+
+	if _, err := ParseWithParams("SELECT * WHERE user = :uid, score >= :max", params); err == nil {
+		t.Error("expected an error for a missing parameter")
+	}
+	if _, err := Parse("SELECT * WHERE user = :uid"); err == nil {
+		t.Error("expected an error for a parameter without a value")
+	}
+}
+
+func TestParseAggregateInWhere(t *testing.T) {
+	queries := []string{
+		"SELECT * WHERE count(*) > 5",
+		"SELECT a WHERE SUM(b) > 5 GROUP BY a",
+		"SELECT * WHERE a = 1, max( * ) = 2",
+	}
+	for _, query := range queries {
+		_, err := Parse(query)
+		if err == nil || !strings.Contains(err.Error(), "HAVING") {
+			t.Errorf("%s: expected an error mentioning HAVING, got %v", query, err)
+		}
+	}
+
+	if _, err := Parse("SELECT * WHERE len(*) > 5"); err == nil {
+		t.Error("expected an error for len(*)")
+	}
+}
+
+func TestParseLimitAll(t *testing.T) {
+	q, err := Parse("SELECT * WHERE a = 1 limit all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.LimitAll || q.Limit != 0 {
+		t.Errorf("expected LIMIT ALL, got limit %d, all %v", q.Limit, q.LimitAll)
+	}
+	checkIDs(t, testDataTable{}, "SELECT * LIMIT ALL", 1, 2, 3, 4)
+}
+
+func TestParseDistinctOn(t *testing.T) {
+	q, err := Parse("select distinct  on ( user_id, kind ) * order by user_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ColumnDesc{{Name: "user_id"}, {Name: "kind"}}
+	if !reflect.DeepEqual(q.DistinctOn, expected) {
+		t.Errorf("expected %v, got %v", expected, q.DistinctOn)
+	}
+	if len(q.Columns) != 1 || q.Columns[0].Name != "*" {
+		t.Errorf("unexpected columns %v", q.Columns)
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	columns, err := ParseColumns(` a, count(b) ,count_if(c > 1), * `)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ColumnDesc{
+		{Name: "a"},
+		{Name: "b", Aggregate: "count"},
+		{Aggregate: "count_if", Filters: []FilterDesc{{Column: "c", Operator: ">", Value: 1}}},
+		{Name: "*"},
+	}
+	if !reflect.DeepEqual(columns, expected) {
+		t.Errorf("expected %v, got %v", expected, columns)
+	}
+
+	for _, s := range []string{"", "SELECT a", "a b", "a, b LIMIT 1", "a,"} {
+		if _, err := ParseColumns(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestParseFilters(t *testing.T) {
+	filters, err := ParseFilters(`status = "open", priority > 3 (len(name) < 10)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "status", Operator: "=", Value: "open"},
+		{Column: "priority", Operator: ">", Value: 3},
+		{Column: "name", Function: "len", Operator: "<", Value: 10},
+	}
+	if !reflect.DeepEqual(filters, expected) {
+		t.Errorf("expected %v, got %v", expected, filters)
+	}
+
+	for _, s := range []string{"", "WHERE a = 1", "a = 1 LIMIT 1", "a"} {
+		if _, err := ParseFilters(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestParseDefaultMaxParenDepth(t *testing.T) {
+	nested := func(depth int) string {
+		return "SELECT * WHERE " + strings.Repeat("(", depth) + "a = 1" + strings.Repeat(")", depth)
+	}
+
+	if _, err := Parse(nested(DefaultMaxParenDepth)); err != nil {
+		t.Errorf("depth %d: %v", DefaultMaxParenDepth, err)
+	}
+	if _, err := Parse(nested(DefaultMaxParenDepth + 1)); err == nil {
+		t.Errorf("depth %d: expected an error", DefaultMaxParenDepth+1)
+	}
+	if _, err := ParseFilters(strings.Repeat("(", 1000000)); err == nil {
+		t.Error("expected an error for deeply nested filters")
+	}
+
+	// A Parser's limit can be changed without affecting Parse.
+	p := NewParser()
+	p.MaxParenDepth = 2
+	if _, err := p.Parse(nested(2)); err != nil {
+		t.Errorf("depth 2: %v", err)
+	}
+	if _, err := p.Parse(nested(3)); err == nil {
+		t.Error("depth 3: expected an error from a Parser with a limit of 2")
+	}
+	p.MaxParenDepth = 0
+	if _, err := p.Parse(nested(DefaultMaxParenDepth + 1)); err != nil {
+		t.Errorf("expected no limit, got %v", err)
+	}
+	if _, err := Parse(nested(3)); err != nil {
+		t.Errorf("depth 3: %v", err)
+	}
+
+	// Parentheses in strings don't count.
+	if _, err := Parse(`SELECT * WHERE a = "` + strings.Repeat(`(\"`, 200) + `"`); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseCasts(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected interface{}
+	}{
+		{`int("8080")`, 8080},
+		{`int(2.0)`, 2},
+		{`float(3)`, 3.0},
+		{`float("1e3")`, 1000.0},
+		{`string(5)`, "5"},
+		{`STRING( 2.5 )`, "2.5"},
+		{`bool("true")`, true},
+		{`bool(0)`, false},
+		{`int(string(7))`, 7},
+		{`string(:port)`, "8080"},
+		{`string(bool("t"))`, "true"},
+		{`bool(" Off")`, false},
+	}
+	params := map[string]interface{}{"port": 8080}
+	for _, c := range cases {
+		q, err := ParseWithParams("SELECT * WHERE a = "+c.value, params)
+		if err != nil {
+			t.Errorf("%s: %v", c.value, err)
+			continue
+		}
+		if v := q.Filters[0].Value; v != c.expected {
+			t.Errorf("%s: expected %#v, got %#v", c.value, c.expected, v)
+		}
+	}
+
+	for _, value := range []string{`int("abc")`, `int(2.5)`, `bool(2)`, `bool("maybe")`, `float(bool("true"))`, `int(now())`, `integer(1)`} {
+		if _, err := Parse("SELECT * WHERE a = " + value); err == nil {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+}
+
+func TestSupportedOperators(t *testing.T) {
+	operators := SupportedOperators()
+	if len(operators) == 0 || operators[0] != "=" {
+		t.Fatalf("unexpected operators %v", operators)
+	}
+	for _, op := range operators {
+		value := `"x"`
+		switch op {
+		case "in":
+			value = `("x")`
+		case "between", "not between":
+			value = `"x" AND "y"`
+		case "is null", "is not null":
+			value = ""
+		}
+		q, err := Parse(`SELECT * WHERE a ` + op + ` ` + value)
+		if err != nil {
+			t.Errorf("%s: %v", op, err)
+			continue
+		}
+		if q.Filters[0].Operator != op || stringToFilterType(op) == FilterUnknown {
+			t.Errorf("%s: parsed as %s", op, q.Filters[0].Operator)
+		}
+	}
+}
+
+func TestSupportedAggregates(t *testing.T) {
+	for _, name := range SupportedAggregates() {
+		query := "SELECT " + name + "(a)"
+		if name == "count_if" {
+			query = "SELECT count_if(a = 1)"
+		}
+		q, err := Parse(query)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if q.Columns[0].Aggregate != name {
+			t.Errorf("%s: parsed as %s", name, q.Columns[0].Aggregate)
+		}
+	}
+}
+
+func TestParseFilterAlternatives(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE status = "open" | "pending"|"closed", id != 1 | int("2"), a = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{
+		[]interface{}{"open", "pending", "closed"},
+		[]interface{}{1, 2},
+		1,
+	}
+	for i, f := range q.Filters {
+		if !reflect.DeepEqual(f.Value, expected[i]) {
+			t.Errorf("filter %d: expected %#v, got %#v", i, expected[i], f.Value)
+		}
+	}
+
+	for _, query := range []string{`SELECT * WHERE a > 1 | 2`, `SELECT * WHERE a matches "x" | "y"`, `SELECT * WHERE a = 1 |`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseSample(t *testing.T) {
+	q, err := Parse("SELECT * WHERE sample(10), SAMPLE( 2.5 , user_id ), sample_rate = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Operator: "sample", Value: 10},
+		{Column: "user_id", Operator: "sample", Value: 2.5},
+		{Column: "sample_rate", Operator: "=", Value: 1},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+
+	for _, query := range []string{"SELECT * WHERE sample(101)", "SELECT * WHERE sample(-1)", "SELECT * WHERE sample(a)"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseQuantifiers(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE any(scores > 90), ALL(len(tags) < 3), anything = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "scores", Operator: ">", Value: 90, Quantifier: "any"},
+		{Column: "tags", Function: "len", Operator: "<", Value: 3, Quantifier: "all"},
+		{Column: "anything", Operator: "=", Value: 1},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+}
+
+func TestParseDetailedError(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{
+			"SELECT * WHERE a = = 1",
+			"parse error at line 1, column 20:\nSELECT * WHERE a = = 1\n                   ^",
+		},
+		{
+			"SELECT *\n\tWHERE a >\n  ) LIMIT 5",
+			"parse error at line 3, column 3:\n  ) LIMIT 5\n  ^",
+		},
+		{
+			"SELECT *\n\tWHERE a > ) ",
+			"parse error at line 2, column 12:\n\tWHERE a > ) \n\t          ^",
+		},
+		{
+			"SELECT a,",
+			"parse error at line 1, column 10:\nSELECT a,\n         ^",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := Parse(c.query)
+		detailed, ok := err.(interface{ DetailedError() string })
+		if !ok {
+			t.Fatalf("%q: expected a detailed error, got %v", c.query, err)
+		}
+		if s := detailed.DetailedError(); s != c.expected {
+			t.Errorf("%q: expected\n%s\ngot\n%s", c.query, c.expected, s)
+		}
+	}
+}
+
+func TestParseComments(t *testing.T) {
+	q, err := Parse(`-- @name: daily_report
+-- @owner:  ops team
+--plain comment
+SELECT * -- every column
+WHERE a = 1, -- first
+  b = "-- not a comment"
+LIMIT 5 --`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"@name: daily_report", "@owner:  ops team", "plain comment", "every column", "first", ""}
+	if !reflect.DeepEqual(q.Comments, expected) {
+		t.Errorf("expected %q, got %q", expected, q.Comments)
+	}
+	directives := map[string]string{"name": "daily_report", "owner": "ops team"}
+	if d := q.Directives(); !reflect.DeepEqual(d, directives) {
+		t.Errorf("expected %v, got %v", directives, d)
+	}
+	if q.Filters[1].Value != "-- not a comment" || q.Limit != 5 {
+		t.Errorf("unexpected query %+v", q)
+	}
+
+	q, err = Parse("SELECT a -- x\n, max(b -- y\n) WHERE c = int( -- z\n\"1\")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Columns[0].Name != "a" || q.Columns[1].Name != "b" || q.Filters[0].Value != 1 {
+		t.Errorf("comments changed the parsed query: %+v", q)
+	}
+
+	if _, err := Parse("SELECT * WHERE " + strings.Repeat("(", DefaultMaxParenDepth+1) + `a = 1 -- "` + "\n" + strings.Repeat(")", DefaultMaxParenDepth+1)); err == nil {
+		t.Error("expected an error for deep nesting after a comment with a quote")
+	}
+}
+
+func TestExpressionActionOrdering(t *testing.T) {
+	// Actions that run before the slice they modify has an element
+	// are no-ops instead of panics.
+	e := &expression{}
+	e.SetColumnName("a")
+	e.SetColumnAggregate("count")
+	e.SetFilterColumn("a")
+	e.SetFilterOperator("=")
+	e.EndCast()
+	e.currentSection = "group by"
+	e.SetColumnName("a")
+	e.columnFilters = true
+	e.SetFilterValueString(`"x"`)
+	if len(e.query.Columns) != 0 || len(e.query.GroupBy) != 0 || len(e.query.Filters) != 0 {
+		t.Errorf("unexpected query %+v", e.query)
+	}
+
+	// In order, each action applies to the last column of the
+	// current section.
+	e = &expression{}
+	e.AddColumn()
+	e.currentSection = "columns"
+	e.AddColumn()
+	e.SetColumnAggregate("MAX")
+	e.SetColumnName("b")
+	e.currentSection = "order by"
+	e.AddColumn()
+	e.SetColumnName("c")
+	expected := Query{
+		Columns: []ColumnDesc{{Name: "b", Aggregate: "max"}},
+		OrderBy: []ColumnDesc{{Name: "c"}},
+	}
+	if !reflect.DeepEqual(e.query, expected) {
+		t.Errorf("expected %+v, got %+v", expected, e.query)
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	q, err := Parse("SELECT * WHERE a = 1 LIMIT 10 offset 20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Limit != 10 || q.Offset != 20 {
+		t.Errorf("expected LIMIT 10 OFFSET 20, got limit %d, offset %d", q.Limit, q.Offset)
+	}
+	if q, err := Parse("SELECT * OFFSET 5"); err != nil || q.Offset != 5 || q.Limit != 0 {
+		t.Errorf("expected OFFSET 5, got %v, %v", q, err)
+	}
+
+	for _, query := range []string{"SELECT * OFFSET -1", "SELECT * OFFSET 1 LIMIT 2", "SELECT * OFFSET", "SELECT * WHERE offset = 1"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseOr(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE a = 1 OR b = 2, c = 3`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Or: [][]FilterDesc{
+			{{Column: "a", Operator: "=", Value: 1}},
+			{{Column: "b", Operator: "=", Value: 2}},
+		}},
+		{Column: "c", Operator: "=", Value: 3},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+
+	q, err = Parse(`SELECT * WHERE a = 1 or (b = 2, c = 3) ORDER BY a`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []FilterDesc{
+		{Or: [][]FilterDesc{
+			{{Column: "a", Operator: "=", Value: 1}},
+			{{Column: "b", Operator: "=", Value: 2}, {Column: "c", Operator: "=", Value: 3}},
+		}},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if pretty := q.Pretty(); !strings.Contains(pretty, `a = 1 OR (b = 2, c = 3)`) {
+		t.Errorf("unexpected formatting: %s", pretty)
+	}
+	if again, err := Parse(q.Pretty()); err != nil || !reflect.DeepEqual(again.Filters, q.Filters) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.Pretty(), again, err)
+	}
+
+	// Filters without OR stay flat.
+	q, err = Parse(`SELECT * WHERE (a = 1), ordered = 2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Filters) != 2 || q.Filters[0].Or != nil || q.Filters[1].Column != "ordered" {
+		t.Errorf("expected two plain filters, got %v", q.Filters)
+	}
+
+	for _, query := range []string{"SELECT * WHERE a = 1 OR", "SELECT * WHERE OR a = 1", "SELECT * WHERE or = 1"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseAnd(t *testing.T) {
+	comma, err := Parse(`SELECT * WHERE a = 1, b = 2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{
+		`SELECT * WHERE a = 1 AND b = 2`,
+		`SELECT * WHERE a = 1 and b = 2`,
+		`SELECT * WHERE (a = 1 AND b = 2)`,
+	} {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if !reflect.DeepEqual(q.Filters, comma.Filters) {
+			t.Errorf("%s: expected %v, got %v", query, comma.Filters, q.Filters)
+		}
+	}
+
+	q, err := Parse(`SELECT * WHERE a = 1 AND b = 2, c = 3 AND android = 4`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns := []string{}
+	for _, f := range q.Filters {
+		columns = append(columns, f.Column)
+	}
+	if !reflect.DeepEqual(columns, []string{"a", "b", "c", "android"}) {
+		t.Errorf("expected filters on a, b, c, and android, got %v", q.Filters)
+	}
+
+	// AND binds tighter than OR.
+	q, err = Parse(`SELECT * WHERE a = 1 OR b = 2 AND c = 3`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Or: [][]FilterDesc{
+			{{Column: "a", Operator: "=", Value: 1}},
+			{{Column: "b", Operator: "=", Value: 2}, {Column: "c", Operator: "=", Value: 3}},
+		}},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+
+	for _, query := range []string{"SELECT * WHERE a = 1 AND", "SELECT * WHERE a = 1 AND, b = 2", "SELECT * WHERE and = 1"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseIn(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE status IN ("open", "pending"), id in (1, 2) OR id IN ()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "status", Operator: "in", Value: []interface{}{"open", "pending"}},
+		{Or: [][]FilterDesc{
+			{{Column: "id", Operator: "in", Value: []interface{}{1, 2}}},
+			{{Column: "id", Operator: "in", Value: []interface{}{}}},
+		}},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if again, err := Parse(q.Pretty()); err != nil || !reflect.DeepEqual(again.Filters, q.Filters) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.Pretty(), again, err)
+	}
+
+	for _, query := range []string{`SELECT * WHERE a IN "x"`, `SELECT * WHERE a IN ("x" | "y")`, `SELECT * WHERE a IN (1,)`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseBetween(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE age BETWEEN 18 AND 65 AND score between -1.5 and 2.5e1, x = 1, y NOT  BETWEEN 1 AND 2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "age", Operator: "between", Value: []interface{}{18, 65}},
+		{Column: "score", Operator: "between", Value: []interface{}{-1.5, 25.0}},
+		{Column: "x", Operator: "=", Value: 1},
+		{Column: "y", Operator: "not between", Value: []interface{}{1, 2}},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if again, err := Parse(q.Pretty()); err != nil || !reflect.DeepEqual(again.Filters, q.Filters) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.Pretty(), again, err)
+	}
+
+	for _, query := range []string{`SELECT * WHERE a BETWEEN 1`, `SELECT * WHERE a BETWEEN 1, 2`, `SELECT * WHERE a BETWEEN 1 AND`, `SELECT * WHERE a NOT 1`, `SELECT * WHERE a NOTBETWEEN 1 AND 2`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseNull(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE a IS NULL, b is not  null, c = NULL, d = 1 | null`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "a", Operator: "is null"},
+		{Column: "b", Operator: "is not null"},
+		{Column: "c", Operator: "="},
+		{Column: "d", Operator: "=", Value: []interface{}{1, nil}},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if again, err := Parse(q.Pretty()); err != nil || !reflect.DeepEqual(again.Filters, q.Filters) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.Pretty(), again, err)
+	}
+
+	for _, query := range []string{`SELECT * WHERE a IS 1`, `SELECT * WHERE a IS NOT`, `SELECT * WHERE null = 1`, `SELECT * WHERE is = 1`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseAlias(t *testing.T) {
+	q, err := Parse(`SELECT region AS r, count(id) as total, count_if(ok = 1) AS ok_count, count(*) AS n GROUP BY region`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ColumnDesc{
+		{Name: "region", Alias: "r"},
+		{Name: "id", Aggregate: "count", Alias: "total"},
+		{Aggregate: "count_if", Filters: []FilterDesc{{Column: "ok", Operator: "=", Value: 1}}, Alias: "ok_count"},
+		{Name: "*", Aggregate: "count", Alias: "n"},
+	}
+	if !reflect.DeepEqual(q.Columns, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Columns)
+	}
+	if names := q.OutputColumns(); !reflect.DeepEqual(names, []string{"r", "total", "ok_count", "n"}) {
+		t.Errorf("unexpected output columns %v", names)
+	}
+	if again, err := Parse(q.Pretty()); err != nil || !reflect.DeepEqual(again.Columns, q.Columns) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.Pretty(), again, err)
+	}
+
+	for _, query := range []string{`SELECT * AS everything`, `SELECT a GROUP BY a AS b`, `SELECT a AS`, `SELECT a AS as`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	cases := []struct {
+		query        string
+		line, column int
+	}{
+		{"SELECT * WHERE a = = 1", 1, 20},
+		{"SELECT *\nWHERE a = \"é\" LIMIT x", 2, 21},
+		{"SELECT a,", 1, 10},
+	}
+	for _, c := range cases {
+		_, err := Parse(c.query)
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("%q: expected a ParseError, got %v", c.query, err)
+		}
+		if line, column := parseErr.Position(); line != c.line || column != c.column {
+			t.Errorf("%q: expected line %d, column %d, got line %d, column %d", c.query, c.line, c.column, line, column)
+		}
+		if parseErr.Rule() == "" {
+			t.Errorf("%q: expected a rule", c.query)
+		}
+	}
+
+	if _, err := Parse("SELECT * WHERE a = ="); !strings.Contains(err.Error(), "parse error near") {
+		t.Errorf("expected the usual message, got %v", err)
+	}
+}
+
+func TestParseBoolValues(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE active = true, deleted != FALSE, flag = true | false, truthy = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "active", Operator: "=", Value: true},
+		{Column: "deleted", Operator: "!=", Value: false},
+		{Column: "flag", Operator: "=", Value: []interface{}{true, false}},
+		{Column: "truthy", Operator: "=", Value: 1},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if sql := q.SQL(); !strings.Contains(sql, "active = true") {
+		t.Errorf("expected a bool literal in %s", sql)
+	}
+
+	for _, query := range []string{`SELECT * WHERE true = 1`, `SELECT * WHERE a = true1.5`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseColumnComparison(t *testing.T) {
+	q, err := Parse(`SELECT * WHERE a > b, len(name) = name_length, c = "b", total = qty * price, d < e/f`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []FilterDesc{
+		{Column: "a", Operator: ">", ValueColumn: "b"},
+		{Column: "name", Function: "len", Operator: "=", ValueColumn: "name_length"},
+		{Column: "c", Operator: "=", Value: "b"},
+		{Column: "total", Operator: "=", ValueColumn: "qty", ValueOperator: "*", ValueOperand: "price"},
+		{Column: "d", Operator: "<", ValueColumn: "e", ValueOperator: "/", ValueOperand: "f"},
+	}
+	if !reflect.DeepEqual(q.Filters, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Filters)
+	}
+	if again, err := Parse(q.SQL()); err != nil || !reflect.DeepEqual(again.Filters, q.Filters) {
+		t.Errorf("expected %v to parse back, got %v, %v", q.SQL(), again, err)
+	}
+
+	for _, query := range []string{`SELECT * WHERE a = b | c`, `SELECT * WHERE a = 1 | b`, `SELECT * WHERE a IN (b)`, `SELECT * WHERE a BETWEEN b AND 2`, `SELECT * WHERE a = int(b)`, `SELECT * WHERE a = b * 2`, `SELECT * WHERE a = b * c * d`, `SELECT * WHERE a = b % c`} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestParseFunctionValues(t *testing.T) {
+	q, err := Parse(`SELECT bucket(latency, 100) AS b, bucket(size, -2.5), count(*) GROUP BY b`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ColumnDesc{
+		{Name: "latency", Function: "bucket", Values: []interface{}{100}, Alias: "b"},
+		{Name: "size", Function: "bucket", Values: []interface{}{-2.5}},
+		{Name: "*", Aggregate: "count"},
+	}
+	if !reflect.DeepEqual(q.Columns, expected) {
+		t.Errorf("expected %v, got %v", expected, q.Columns)
+	}
+
+	if _, err := Parse("SELECT bucket(latency, 100, size)"); err == nil {
+		t.Error("expected an error for a column after a value")
+	}
+}
+
+func TestParserProfile(t *testing.T) {
+	p := NewParser()
+	if _, err := p.Parse("SELECT a"); err != nil {
+		t.Fatal(err)
+	}
+	if profile := p.Profile(); len(profile) != 0 {
+		t.Errorf("expected no profile without Profiling, got %v", profile)
+	}
+
+	p.Profiling = true
+	for _, query := range []string{"SELECT a, b WHERE c = 1", "SELECT *", "SELECT * WHERE"} {
+		p.Parse(query)
+	}
+	profile := p.Profile()
+	if stats := profile["Query"]; stats.Matches != 2 || stats.Runes != len("SELECT a, b WHERE c = 1SELECT *") || stats.Duration <= 0 {
+		t.Errorf("unexpected Query stats %+v", stats)
+	}
+	if stats := profile["WhereExpr"]; stats.Matches != 1 || stats.Duration != 0 {
+		t.Errorf("unexpected WhereExpr stats %+v", stats)
+	}
+	if stats := profile["Column"]; stats.Matches != 3 {
+		t.Errorf("expected 3 Column matches, got %+v", stats)
+	}
+}
+
+func TestParserReuse(t *testing.T) {
+	p := NewParser()
+	for _, query := range append(validQueries, formatQueries...) {
+		expected, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		q, err := p.Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if !reflect.DeepEqual(q, expected) {
+			t.Errorf("%s: expected %v, got %v", query, expected, q)
+		}
+	}
+
+	first, err := p.Parse("SELECT * WHERE a = = 1")
+	if err == nil {
+		t.Fatalf("expected an error, got %v", first)
+	}
+	message := err.Error()
+	q, err2 := p.Parse("SELECT a WHERE b = 2")
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	if err.Error() != message {
+		t.Errorf("expected the error not to change, got %v", err)
+	}
+	if len(q.Columns) != 1 || len(q.Filters) != 1 || q.Filters[0].Value != 2 {
+		t.Errorf("unexpected query %v", q)
+	}
+}
+
+func TestParseTokenGrowth(t *testing.T) {
+	// Long queries need more tokens than the token tree starts with,
+	// so it has to grow while parsing them.
+	filters := []string{}
+	for i := 0; i < 500; i++ {
+		filters = append(filters, fmt.Sprintf("(a%d = %d OR b IN (1, 2))", i, i))
+	}
+	q, err := Parse("SELECT * WHERE " + strings.Join(filters, ", "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Filters) != 500 || q.Filters[499].Or[0][0].Column != "a499" {
+		t.Errorf("unexpected filters %v", q.Filters)
+	}
+}
+
+func TestParseConcurrent(t *testing.T) {
+	// Parse reuses parsers, so concurrent parses mustn't share them.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				q, err := Parse(fmt.Sprintf("SELECT a%d WHERE b = %d", i, j))
+				if err != nil || q.Columns[0].Name != fmt.Sprintf("a%d", i) || q.Filters[0].Value != j {
+					t.Errorf("unexpected query %v, %v", q, err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestParseStringEscapes(t *testing.T) {
+	cases := []struct {
+		literal  string
+		expected string
+	}{
+		{`"a\nb"`, "a\nb"},
+		{`"a\tb\r\v\f\b\a"`, "a\tb\r\v\f\b\a"},
+		{`"\x41\x42-"`, "AB-"},
+		{`"\xe9"`, "\xe9"},
+		{`"Aé\U0001F600"`, "Aé😀"},
+		{`"\101\60\0"`, "A0\x00"},
+		{`"\1012"`, "A2"},
+		{`"say \"hi\" \\ \'x\' \?"`, `say "hi" \ 'x' ?`},
+		{`"50\% off\_"`, `50% off_`},
+		{`"a""b"`, "ab"},
+	}
+	for _, c := range cases {
+		q, err := Parse("SELECT * WHERE a = " + c.literal)
+		if err != nil {
+			t.Fatal(c.literal, err)
+		}
+		if v := q.Filters[0].Value; v != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.literal, c.expected, v)
+		}
+		again, err := Parse(q.SQL())
+		if err != nil || again.Filters[0].Value != c.expected {
+			t.Errorf("%s: expected %s to parse back, got %v, %v", c.literal, q.SQL(), again, err)
+		}
+	}
+
+	q, err := Parse(`SELECT * WHERE a LIKE "50\% off\_""\x41"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := q.Filters[0].Value; v != `50\% off\_A` {
+		t.Errorf("expected LIKE wildcard escapes to be kept, got %q", v)
+	}
+
+	q, err = Parse(`SELECT * WHERE json_extract(payload, "a\tb") = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arg := q.Filters[0].Arguments[0]; arg != "a\tb" {
+		t.Errorf("expected an unescaped argument, got %q", arg)
+	}
+}
+
+func TestParseLimitOutOfRange(t *testing.T) {
+	for _, query := range []string{"SELECT * LIMIT 99999999999999999999", "SELECT * LIMIT 1 OFFSET 99999999999999999999"} {
+		if q, err := Parse(query); err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("%s: expected an out of range error, got %v, %v", query, q, err)
+		}
+	}
+	if q, err := Parse("SELECT * LIMIT 2147483647"); err != nil || q.Limit != math.MaxInt32 {
+		t.Errorf("expected the largest limit, got %v, %v", q, err)
+	}
+}
@@ -1,31 +1,437 @@
 package query
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // Query describes a query.
 type Query struct {
 	Columns    []ColumnDesc `json:"columns,omitempty"`
+	Distinct   bool         `json:"distinct,omitempty"`
+	DistinctOn []ColumnDesc `json:"distinct_on,omitempty"`
 	GroupBy    []ColumnDesc `json:"group_by,omitempty"`
 	Filters    []FilterDesc `json:"filters,omitempty"`
 	OrderBy    []ColumnDesc `json:"order_by,omitempty"`
 	Descending bool         `json:"descending"`
 	Limit      int          `json:"limit,omitempty"`
+
+	// Ascending is set by an explicit ASC, which keeps the ORDER BY
+	// ascending even with WithDefaultOrderDescending.
+	Ascending bool `json:"ascending,omitempty"`
+
+	// LimitAll is set by LIMIT ALL, which explicitly asks for every
+	// row. It's only meaningful when Limit is 0.
+	LimitAll bool `json:"limit_all,omitempty"`
+
+	// Offset is the number of rows of the result to skip before the
+	// rows that are returned.
+	Offset int `json:"offset,omitempty"`
+
+	// Comments are the text of the query's -- comments, in order,
+	// wherever they appear in the query.
+	Comments []string `json:"comments,omitempty"`
+}
+
+// OutputColumns returns the names of the columns a result of the query
+// has, in order, without executing it. Columns are named by their alias
+// if they have one, and aggregates otherwise by their expression, like
+// "count(id)". A "*" is returned as is since the fields it expands to
+// depend on the rows.
+func (q *Query) OutputColumns() []string {
+	names := []string{}
+	for _, c := range q.Columns {
+		names = append(names, columnName(c))
+	}
+	return names
+}
+
+// columnName returns the name of a column in results.
+func columnName(c ColumnDesc) string {
+	if c.Alias != "" {
+		return c.Alias
+	}
+	return formatColumn(c)
+}
+
+// grouped reports whether the query has a GROUP BY or aggregates, so
+// its result has a row per group rather than per matching row.
+func (q *Query) grouped() bool {
+	if len(q.GroupBy) > 0 {
+		return true
+	}
+	for _, c := range q.Columns {
+		if c.Aggregate != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Directives returns the comments of the query written like
+// "-- @name: value" as a map from name to value. If a name appears more
+// than once, the last value wins.
+func (q *Query) Directives() map[string]string {
+	directives := map[string]string{}
+	for _, comment := range q.Comments {
+		if !strings.HasPrefix(comment, "@") {
+			continue
+		}
+		name, value, ok := strings.Cut(comment[1:], ":")
+		if !ok {
+			continue
+		}
+		directives[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return directives
 }
 
 // ColumnDesc describes a column.
 type ColumnDesc struct {
 	Name      string `json:"name"`
 	Aggregate string `json:"aggregate,omitempty"`
+
+	// Function is a function applied to the column's value in each
+	// row, like len in ORDER BY len(name).
+	Function string `json:"function,omitempty"`
+
+	// Arguments are the columns after Name for aggregates and functions
+	// that take more than one, e.g. y in corr(x, y).
+	Arguments []string `json:"arguments,omitempty"`
+
+	// Values are the literal arguments of a function after its
+	// columns, e.g. 100 in bucket(latency, 100).
+	Values []interface{} `json:"values,omitempty"`
+
+	// Filters is the condition of a conditional aggregate, e.g.
+	// count_if(status = "error"). Rows are only aggregated if they
+	// match every filter.
+	Filters []FilterDesc `json:"filters,omitempty"`
+
+	// Alias is the name given to the column with AS, as in
+	// count(id) AS total.
+	Alias string `json:"alias,omitempty"`
+}
+
+// aggregates maps the names of the aggregate functions to functions
+// that return a new Aggregator for them. It's nil for aggregates the
+// executor doesn't support yet.
+var aggregates = map[string]func() Aggregator{
+	"any":      newAnyAggregator,
+	"count":    newCountAggregator,
+	"count_if": newCountAggregator,
+	"sum":      newSumAggregator,
+	"avg":      newAvgAggregator,
+	"min":      newMinAggregator,
+	"max":      newMaxAggregator,
+	"corr":     newCorrAggregator,
+	"mode":     newModeAggregator,
+	"stddev":   newStddevAggregator,
+	"variance": newVarianceAggregator,
+}
+
+// aggregateTypes maps aggregates whose results always have the same
+// type to the name of the type, as returned by Result.ColumnInfo.
+var aggregateTypes = map[string]string{
+	"count":    "int",
+	"count_if": "int",
+	"avg":      "float",
+	"stddev":   "float",
+	"variance": "float",
+	"corr":     "float",
+}
+
+// aggregateColumns is the number of columns an aggregate takes, if
+// it's not one.
+var aggregateColumns = map[string]int{
+	"corr": 2,
+}
+
+// SupportedAggregates returns the names of the aggregate functions,
+// sorted.
+func SupportedAggregates() []string {
+	names := []string{}
+	for name := range aggregates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // FilterDesc represents a filter expression.
 type FilterDesc struct {
-	Column   string      `json:"column"`
-	Operator string      `json:"operator"`
-	Value    interface{} `json:"value"`
+	Column   string `json:"column"`
+	Function string `json:"function,omitempty"`
+	// Arguments are the function's arguments after the column, as in
+	// json_extract(payload, "items[0].price").
+	Arguments []interface{} `json:"arguments,omitempty"`
+	Operator  string        `json:"operator"`
+	Value     interface{}   `json:"value"`
+
+	// ValueColumn, if set, is a column of the same row that the
+	// filter compares to instead of Value, as in a > b.
+	ValueColumn string `json:"value_column,omitempty"`
+
+	// ValueOperator and ValueOperand, if set, make the filter compare
+	// to ValueColumn combined with the ValueOperand column by +, -, *,
+	// or /, as in total = qty * price.
+	ValueOperator string `json:"value_operator,omitempty"`
+	ValueOperand  string `json:"value_operand,omitempty"`
+
+	// Quantifier is "any" or "all" for a filter on the elements of a
+	// slice, as in any(scores > 90).
+	Quantifier string `json:"quantifier,omitempty"`
+
+	// Or, if set, makes the filter match rows that match every filter
+	// of any one of its alternatives, as in a = 1 OR (b = 2, c = 3).
+	// The other fields are unused.
+	Or [][]FilterDesc `json:"or,omitempty"`
+}
+
+// Now is a filter value for now() in a query. It resolves to the
+// current Unix time in seconds, plus Offset seconds, when the query is
+// executed.
+type Now struct {
+	Offset int `json:"offset"`
 }
 
 func (q Query) String() string {
 	b, _ := json.Marshal(q)
 	return string(b)
 }
+
+// Validate checks the query for mistakes that would silently produce
+// wrong results. A query with aggregates or a GROUP BY may only select
+// and order by bare columns that appear in its GROUP BY, like in SQL.
+// DISTINCT ON columns must be bare and, with an ORDER BY, must match
+// its leading columns so the sort decides which row of each group is
+// kept.
+func (q *Query) Validate() error {
+	q = q.resolveAliases()
+	if err := q.validateDistinctOn(); err != nil {
+		return err
+	}
+	if err := q.validateFunctions(); err != nil {
+		return err
+	}
+
+	aggregated := false
+	for _, c := range q.Columns {
+		if c.Aggregate == "" {
+			continue
+		}
+		aggregated = true
+		if c.Name == "*" && c.Aggregate != "count" {
+			return fmt.Errorf("query: %s(*) isn't supported; only count(*) is", c.Aggregate)
+		}
+		if c.Aggregate == "count_if" && len(c.Filters) == 0 {
+			return fmt.Errorf("query: count_if() needs a condition")
+		}
+		n, ok := aggregateColumns[c.Aggregate]
+		if !ok {
+			n = 1
+		}
+		if 1+len(c.Arguments) != n {
+			return fmt.Errorf("query: %s() takes %d column(s), got %d", c.Aggregate, n, 1+len(c.Arguments))
+		}
+	}
+	if !aggregated && len(q.GroupBy) == 0 {
+		return nil
+	}
+
+	// GROUP BY columns are selected by their expressions, like
+	// bucket(latency, 100), and functions in ORDER BY can also apply to
+	// a GROUP BY column, as in GROUP BY name ORDER BY len(name).
+	grouped := map[string]bool{}
+	for _, c := range q.GroupBy {
+		if c.Aggregate == "" {
+			grouped[formatColumn(c)] = true
+		}
+	}
+	for _, c := range q.Columns {
+		if c.Aggregate != "" || grouped[formatColumn(c)] {
+			continue
+		}
+		if c.Name == "*" {
+			if aggregated {
+				return fmt.Errorf("query: * cannot be selected with aggregates")
+			}
+			continue
+		}
+		return fmt.Errorf("query: column %q must appear in GROUP BY or be used in an aggregate", formatColumn(c))
+	}
+	for _, c := range q.OrderBy {
+		if c.Aggregate == "" && !grouped[formatColumn(c)] && !(len(c.Arguments) == 0 && grouped[c.Name]) {
+			return fmt.Errorf("query: ORDER BY column %q must appear in GROUP BY or be used in an aggregate", formatColumn(c))
+		}
+	}
+	return nil
+}
+
+// resolveAliases returns the query with ORDER BY columns that name an
+// alias replaced by the selected columns, as in
+// SELECT count(id) AS n ORDER BY n, and likewise GROUP BY columns that
+// name the alias of a selected column that isn't an aggregate, as in
+// SELECT bucket(latency, 100) AS b, count(*) GROUP BY b. If there are
+// none, it returns q.
+func (q *Query) resolveAliases() *Query {
+	orderBy := resolveColumnAliases(q.OrderBy, q.Columns, true)
+	groupBy := resolveColumnAliases(q.GroupBy, q.Columns, false)
+	if orderBy == nil && groupBy == nil {
+		return q
+	}
+	resolved := q.Clone()
+	if orderBy != nil {
+		resolved.OrderBy = orderBy
+	}
+	if groupBy != nil {
+		resolved.GroupBy = groupBy
+	}
+	return resolved
+}
+
+// resolveColumnAliases returns a copy of columns with the ones that name
+// the alias of a selected column replaced by it, or nil if there are
+// none. Selected aggregates are only used if aggregates is set.
+func resolveColumnAliases(columns, selected []ColumnDesc, aggregates bool) []ColumnDesc {
+	var resolved []ColumnDesc
+	for i, c := range columns {
+		if c.Aggregate != "" || c.Function != "" {
+			continue
+		}
+		for _, s := range selected {
+			if s.Alias == "" || s.Alias != c.Name || s.Aggregate != "" && !aggregates {
+				continue
+			}
+			if resolved == nil {
+				resolved = cloneColumns(columns)
+			}
+			resolved[i] = s
+			resolved[i].Alias = ""
+			break
+		}
+	}
+	return resolved
+}
+
+func (q *Query) validateDistinctOn() error {
+	for i, c := range q.DistinctOn {
+		if c.Aggregate != "" || c.Name == "*" {
+			return fmt.Errorf("query: DISTINCT ON only supports columns")
+		}
+		if len(q.OrderBy) == 0 {
+			continue
+		}
+		if i >= len(q.OrderBy) || q.OrderBy[i].Name != c.Name || q.OrderBy[i].Aggregate != "" || q.OrderBy[i].Function != "" {
+			return fmt.Errorf("query: DISTINCT ON columns must match the leading ORDER BY columns")
+		}
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the query. Filter values are copied by
+// assignment, except lists of values, like those of IN, which are
+// copied too.
+func (q *Query) Clone() *Query {
+	clone := *q
+	clone.Columns = cloneColumns(q.Columns)
+	clone.DistinctOn = cloneColumns(q.DistinctOn)
+	clone.GroupBy = cloneColumns(q.GroupBy)
+	clone.OrderBy = cloneColumns(q.OrderBy)
+	clone.Filters = cloneFilters(q.Filters)
+	if q.Comments != nil {
+		clone.Comments = append([]string(nil), q.Comments...)
+	}
+	return &clone
+}
+
+// Redacted returns a copy of the query with every filter value and
+// literal function argument replaced by "?", so the shape of the query
+// can be logged without its values. Filters comparing to other columns,
+// like a > b, are kept as they are.
+func (q *Query) Redacted() *Query {
+	clone := q.Clone()
+	redactFilters(clone.Filters)
+	for _, columns := range [][]ColumnDesc{clone.Columns, clone.GroupBy, clone.OrderBy} {
+		for _, c := range columns {
+			redactFilters(c.Filters)
+			for i := range c.Values {
+				c.Values[i] = "?"
+			}
+		}
+	}
+	return clone
+}
+
+func cloneColumns(columns []ColumnDesc) []ColumnDesc {
+	if columns == nil {
+		return nil
+	}
+	clone := make([]ColumnDesc, len(columns))
+	for i, c := range columns {
+		c.Filters = cloneFilters(c.Filters)
+		if c.Arguments != nil {
+			c.Arguments = append([]string(nil), c.Arguments...)
+		}
+		if c.Values != nil {
+			c.Values = append([]interface{}(nil), c.Values...)
+		}
+		clone[i] = c
+	}
+	return clone
+}
+
+func cloneFilters(filters []FilterDesc) []FilterDesc {
+	if filters == nil {
+		return nil
+	}
+	clone := make([]FilterDesc, len(filters))
+	for i, f := range filters {
+		if f.Arguments != nil {
+			f.Arguments = append([]interface{}(nil), f.Arguments...)
+		}
+		f.Value = cloneValue(f.Value)
+		if f.Or != nil {
+			or := make([][]FilterDesc, len(f.Or))
+			for j := range f.Or {
+				or[j] = cloneFilters(f.Or[j])
+			}
+			f.Or = or
+		}
+		clone[i] = f
+	}
+	return clone
+}
+
+// cloneValue returns a copy of a filter value that doesn't share its
+// lists.
+func cloneValue(v interface{}) interface{} {
+	values, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+	clone := make([]interface{}, len(values))
+	for i := range values {
+		clone[i] = cloneValue(values[i])
+	}
+	return clone
+}
+
+func redactFilters(filters []FilterDesc) {
+	for i := range filters {
+		if filters[i].Or != nil {
+			for _, alternative := range filters[i].Or {
+				redactFilters(alternative)
+			}
+			continue
+		}
+		// Filters comparing to other columns have no value to hide,
+		// and keep their shape, like a > b.
+		if filters[i].ValueColumn != "" {
+			continue
+		}
+		filters[i].Value = "?"
+	}
+}
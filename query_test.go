@@ -0,0 +1,180 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"SELECT *",
+		"SELECT a, b",
+		"SELECT count(a)",
+		"SELECT name, count(id) GROUP BY name",
+		"SELECT a, b, min(c), sum(d) GROUP BY a, b",
+		"SELECT * GROUP BY a",
+		"SELECT DISTINCT ON (a) *",
+		"SELECT DISTINCT ON (a, b) * ORDER BY a, b, c DESC",
+	}
+	invalid := []string{
+		"SELECT name, count(id)",
+		"SELECT a, b, min(c) GROUP BY a",
+		"SELECT b GROUP BY a",
+		"SELECT *, count(id)",
+		"SELECT DISTINCT ON (count(a)) *",
+		"SELECT DISTINCT ON (a) * ORDER BY b",
+		"SELECT DISTINCT ON (a, b) * ORDER BY a",
+		"SELECT a, count(b) GROUP BY a ORDER BY c",
+		"SELECT count_if(b)",
+	}
+
+	for _, query := range valid {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if err := q.Validate(); err != nil {
+			t.Errorf("%s: %v", query, err)
+		}
+	}
+	for _, query := range invalid {
+		q, err := Parse(query)
+		if err != nil {
+			t.Fatal(query, err)
+		}
+		if err := q.Validate(); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+
+	q, _ := Parse("SELECT name, count(id)")
+	expected := `query: column "name" must appear in GROUP BY or be used in an aggregate`
+	if err := q.Validate(); err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
+
+func TestClone(t *testing.T) {
+	q, err := Parse(`SELECT a, count_if(b = 1) WHERE c = "x" GROUP BY a ORDER BY a LIMIT 3`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := q.Clone()
+	if !reflect.DeepEqual(q, clone) {
+		t.Fatalf("expected %v, got %v", q, clone)
+	}
+
+	clone.Columns[1].Filters[0].Value = 2
+	clone.Filters[0].Column = "d"
+	clone.GroupBy[0].Name = "e"
+	if q.Columns[1].Filters[0].Value != 1 || q.Filters[0].Column != "c" || q.GroupBy[0].Name != "a" {
+		t.Errorf("modifying the clone changed the original: %v", q)
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	q, err := Parse(`SELECT a, count_if(b = 1) WHERE ssn = "123-45-6789", age > 30`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	redacted := q.Redacted()
+	expected := `SELECT a, count_if(b = "?")
+WHERE
+  ssn = "?",
+  age > "?"`
+	if pretty := redacted.Pretty(); pretty != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, pretty)
+	}
+	if q.Filters[0].Value != "123-45-6789" {
+		t.Errorf("redacting changed the original: %v", q)
+	}
+
+	// Comparisons to columns are left as they are.
+	q, err = Parse(`SELECT count_if(b > c, d = 2) WHERE a > b, total = qty * price OR e = "x"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `SELECT count_if(b > c, d = "?") WHERE a > b, total = qty * price OR e = "?"`
+	if sql := q.Redacted().SQL(); sql != expected {
+		t.Errorf("expected %s, got %s", expected, sql)
+	}
+}
+
+func TestFilterTreeJSON(t *testing.T) {
+	// ids executes the decoded query, not a reparsed one.
+	ids := func(q *Query) []interface{} {
+		res, err := NewExecutor(testGroups).Execute(q)
+		if err != nil {
+			t.Fatal(q, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	// Nested ORs and ANDs round-trip through JSON as FilterDesc.Or
+	// trees.
+	q, err := Parse(`SELECT * WHERE kind != "c", (region = "us" AND kind = "a" OR (kind = "b", (region = "eu" OR region = "us")))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Query
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Filters, q.Filters) {
+		t.Errorf("expected %v to decode to %v, got %v", string(b), q.Filters, decoded.Filters)
+	}
+	if got := ids(&decoded); !reflect.DeepEqual(got, []interface{}{1, 2, 3, 5, 6}) {
+		t.Errorf("expected ids 1, 2, 3, 5, 6, got %v", got)
+	}
+
+	// Clients can build the tree in JSON themselves.
+	tree := `{"filters": [{"or": [
+		[{"column": "region", "operator": "=", "value": "eu"}],
+		[{"column": "kind", "operator": "=", "value": "a"}, {"or": [
+			[{"column": "region", "operator": "=", "value": "us"}],
+			[{"column": "region", "operator": "is null"}]
+		]}]
+	]}]}`
+	decoded = Query{}
+	if err := json.Unmarshal([]byte(tree), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	expected := `region = "eu" OR (kind = "a", region = "us" OR region IS NULL)`
+	if got := formatFilter(decoded.Filters[0]); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	if got := ids(&decoded); !reflect.DeepEqual(got, []interface{}{1, 2, 4, 5, 6, 7}) {
+		t.Errorf("expected ids 1, 2, 4, 5, 6, 7, got %v", got)
+	}
+}
+
+func TestOutputColumns(t *testing.T) {
+	q, err := Parse(`SELECT region, count(id), count_if(status = "error"), corr(a, b) GROUP BY region`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"region", "count(id)", `count_if(status = "error")`, "corr(a, b)"}
+	if columns := q.OutputColumns(); !reflect.DeepEqual(columns, expected) {
+		t.Errorf("expected %q, got %q", expected, columns)
+	}
+
+	q, err = Parse("SELECT * WHERE a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columns := q.OutputColumns(); !reflect.DeepEqual(columns, []string{"*"}) {
+		t.Errorf("expected [*], got %q", columns)
+	}
+}
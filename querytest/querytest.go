@@ -0,0 +1,73 @@
+// Package querytest provides Table implementations for testing code
+// that uses the query package.
+package querytest
+
+import (
+	"sort"
+
+	"github.com/Preetam/query"
+)
+
+// Row is a query.Row backed by a map.
+type Row map[string]interface{}
+
+// Fields returns the row's fields sorted by name.
+func (r Row) Fields() []string {
+	fields := []string{}
+	for field := range r {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func (r Row) Get(field string) (interface{}, bool) {
+	v, ok := r[field]
+	return v, ok
+}
+
+// FailingTable is a query.Table whose cursors yield the first N rows
+// and then stop with Err. If Err is nil, it behaves like a table of
+// the first N rows. A negative N fails immediately, like zero.
+type FailingTable struct {
+	Rows []Row
+	N    int
+	Err  error
+}
+
+func (t FailingTable) NewCursor() (query.Cursor, error) {
+	rows := t.Rows
+	if t.N <= 0 {
+		rows = nil
+	} else if t.N < len(rows) {
+		rows = rows[:t.N]
+	}
+	return &failingCursor{idx: -1, rows: rows, failErr: t.Err}, nil
+}
+
+type failingCursor struct {
+	idx     int
+	rows    []Row
+	failErr error
+	err     error
+}
+
+func (c *failingCursor) Next() bool {
+	if c.err != nil {
+		return false
+	}
+	c.idx++
+	if c.idx < len(c.rows) {
+		return true
+	}
+	c.err = c.failErr
+	return false
+}
+
+func (c *failingCursor) Row() query.Row {
+	return c.rows[c.idx]
+}
+
+func (c *failingCursor) Err() error {
+	return c.err
+}
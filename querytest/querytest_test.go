@@ -0,0 +1,65 @@
+package querytest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Preetam/query"
+)
+
+var testRows = []Row{
+	{"id": 1},
+	{"id": 2},
+	{"id": 3},
+}
+
+func TestFailingTable(t *testing.T) {
+	errCursor := errors.New("cursor failed")
+	q, err := query.Parse("SELECT *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for n := -1; n <= len(testRows); n++ {
+		_, err := query.NewExecutor(FailingTable{Rows: testRows, N: n, Err: errCursor}).Execute(q)
+		if err != errCursor {
+			t.Errorf("N = %d: expected %v, got %v", n, errCursor, err)
+		}
+	}
+
+	res, err := query.NewExecutor(FailingTable{Rows: testRows, N: 2}).Execute(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := res.Rows(); len(rows) != 2 {
+		t.Errorf("expected 2 rows, got %v", rows)
+	}
+}
+
+func TestFailingTableLimit(t *testing.T) {
+	// The cursor isn't read past the limit, so the error isn't reached.
+	q, err := query.Parse("SELECT * LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := FailingTable{Rows: testRows, N: 2, Err: errors.New("cursor failed")}
+	res, err := query.NewExecutor(table).Execute(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := res.Rows(); len(rows) != 2 {
+		t.Errorf("expected 2 rows, got %v", rows)
+	}
+}
+
+func TestFailingTableCount(t *testing.T) {
+	errCursor := errors.New("cursor failed")
+	q, err := query.Parse("SELECT *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = query.NewExecutor(FailingTable{Rows: testRows, N: 1, Err: errCursor}).Count(q)
+	if err != errCursor {
+		t.Errorf("expected %v, got %v", errCursor, err)
+	}
+}
@@ -0,0 +1,134 @@
+package query
+
+import "fmt"
+
+// A Type is the type of a column in a Schema.
+type Type string
+
+const (
+	TypeInt    Type = "int"
+	TypeFloat  Type = "float"
+	TypeString Type = "string"
+
+	// TypeBool accepts bools, the numbers 0 and 1, and the strings
+	// true, t, yes, y, on, and 1, and false, f, no, n, off, and 0, in
+	// any case.
+	TypeBool Type = "bool"
+
+	// TypeSemver is for semantic versions, like "1.10.0", which compare
+	// as Versions rather than as strings.
+	TypeSemver Type = "semver"
+)
+
+// A Schema maps the columns of a table to their types. With a schema,
+// queries may only reference its columns, and values are coerced to
+// the column's type before they're compared.
+type Schema map[string]Type
+
+// Coerce converts value to the type of column, like a cast in a query.
+func (s Schema) Coerce(column string, value interface{}) (interface{}, error) {
+	typ, ok := s[column]
+	if !ok {
+		return nil, fmt.Errorf("query: unknown column %q", column)
+	}
+	return castValue(value, string(typ))
+}
+
+// Apply checks that the query only references columns in the schema
+// and coerces its filter values, including each of a set of values like
+// "a" | "b", to the types of their columns. Values
+// of filters with a function, like len(name), sample percentages, now()
+// values, and nulls are left as is.
+func (s Schema) Apply(q *Query) error {
+	for _, columns := range [][]ColumnDesc{q.Columns, q.DistinctOn, q.GroupBy, q.OrderBy} {
+		for _, c := range columns {
+			for _, name := range append([]string{c.Name}, c.Arguments...) {
+				if err := s.checkColumn(name); err != nil {
+					return err
+				}
+			}
+			if err := s.applyFilters(c.Filters); err != nil {
+				return err
+			}
+		}
+	}
+	return s.applyFilters(q.Filters)
+}
+
+func (s Schema) checkColumn(name string) error {
+	if name == "" || name == "*" {
+		return nil
+	}
+	if _, ok := s[name]; !ok {
+		return fmt.Errorf("query: unknown column %q", name)
+	}
+	return nil
+}
+
+func (s Schema) applyFilters(filters []FilterDesc) error {
+	for i, f := range filters {
+		if f.Or != nil {
+			for _, alternative := range f.Or {
+				if err := s.applyFilters(alternative); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := s.checkColumn(f.Column); err != nil {
+			return err
+		}
+		for _, column := range []string{f.ValueColumn, f.ValueOperand} {
+			if err := s.checkColumn(column); err != nil {
+				return err
+			}
+		}
+		if _, ok := f.Value.(Now); ok || f.Value == nil || f.Function != "" || f.Operator == FilterSample.String() {
+			continue
+		}
+		if values, ok := f.Value.([]interface{}); ok {
+			coerced := make([]interface{}, len(values))
+			for j, v := range values {
+				if _, ok := v.(Now); ok || v == nil {
+					coerced[j] = v
+					continue
+				}
+				value, err := s.Coerce(f.Column, v)
+				if err != nil {
+					return err
+				}
+				coerced[j] = value
+			}
+			filters[i].Value = coerced
+			continue
+		}
+		value, err := s.Coerce(f.Column, f.Value)
+		if err != nil {
+			return err
+		}
+		filters[i].Value = value
+	}
+	return nil
+}
+
+// ParseWithSchema parses a query and applies the schema to it.
+func ParseWithSchema(query string, schema Schema) (*Query, error) {
+	q, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.Apply(q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// WithSchema makes the executor apply the schema to queries before
+// executing them, and coerce row values to the types of their columns
+// before comparing them in filters. Rows with values that can't be
+// coerced don't match.
+func WithSchema(schema Schema) Option {
+	return func(e *Executor) {
+		e.schema = schema
+	}
+}
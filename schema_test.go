@@ -0,0 +1,168 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testSchema = Schema{
+	"id":    TypeInt,
+	"name":  TypeString,
+	"score": TypeFloat,
+	"admin": TypeBool,
+}
+
+func TestSchemaCoerce(t *testing.T) {
+	cases := []struct {
+		column   string
+		value    interface{}
+		expected interface{}
+	}{
+		{"id", "42", 42},
+		{"id", 3.0, 3},
+		{"id", int64(7), 7},
+		{"name", 5, "5"},
+		{"score", 2, 2.0},
+		{"admin", "true", true},
+	}
+	for _, c := range cases {
+		v, err := testSchema.Coerce(c.column, c.value)
+		if err != nil {
+			t.Errorf("%s %#v: %v", c.column, c.value, err)
+			continue
+		}
+		if v != c.expected {
+			t.Errorf("%s %#v: expected %#v, got %#v", c.column, c.value, c.expected, v)
+		}
+	}
+
+	if _, err := testSchema.Coerce("id", "abc"); err == nil {
+		t.Error("expected an error for an invalid int")
+	}
+	if _, err := testSchema.Coerce("missing", 1); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestParseWithSchema(t *testing.T) {
+	q, err := ParseWithSchema(`SELECT * WHERE id = "5", name != 10, score > 1, len(name) > 2, id < now(), id = "1" | 2`, testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []interface{}{}
+	for _, f := range q.Filters {
+		values = append(values, f.Value)
+	}
+	expected := []interface{}{5, "10", 1.0, 2, Now{}, []interface{}{1, 2}}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %#v, got %#v", expected, values)
+	}
+
+	invalid := []string{
+		`SELECT * WHERE id = "abc"`,
+		`SELECT * WHERE id = 1 | "abc"`,
+		`SELECT * WHERE missing = 1`,
+		`SELECT missing`,
+		`SELECT id, count_if(missing = 1) GROUP BY id`,
+		`SELECT DISTINCT ON (missing) *`,
+	}
+	for _, query := range invalid {
+		if _, err := ParseWithSchema(query, testSchema); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestExecuteWithSchema(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "name": "9", "score": "1.5"},
+		{"id": "2", "name": "10", "score": 3},
+		{"id": 3, "name": 11, "score": "high"},
+	}
+	e := NewExecutor(table, WithSchema(testSchema))
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		// Names compare as strings, so "9" > "10".
+		{`SELECT * WHERE name > "10"`, []interface{}{1, 3}},
+		{`SELECT * WHERE id >= 2`, []interface{}{"2", 3}},
+		{`SELECT * WHERE score > 1`, []interface{}{1, "2"}},
+		{`SELECT * WHERE id = "2"`, []interface{}{"2"}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := e.Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+
+	q, err := Parse("SELECT * WHERE missing = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Execute(q); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+	if q.Filters[0].Value != 1 {
+		t.Error("executing changed the query")
+	}
+}
+
+func TestExecuteBoolColumn(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "admin": true},
+		{"id": 2, "admin": 1},
+		{"id": 3, "admin": "TRUE"},
+		{"id": 4, "admin": " yes"},
+		{"id": 5, "admin": 1.0},
+		{"id": 6, "admin": false},
+		{"id": 7, "admin": int64(0)},
+		{"id": 8, "admin": "off"},
+		{"id": 9, "admin": 2},
+		{"id": 10, "admin": "maybe"},
+	}
+	e := NewExecutor(table, WithSchema(testSchema))
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE admin = bool("true")`, []interface{}{1, 2, 3, 4, 5}},
+		{`SELECT * WHERE admin = 1`, []interface{}{1, 2, 3, 4, 5}},
+		{`SELECT * WHERE admin = "f"`, []interface{}{6, 7, 8}},
+		// Values that aren't booleans never match.
+		{`SELECT * WHERE admin != "y"`, []interface{}{6, 7, 8}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := e.Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+		}
+	}
+}
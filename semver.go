@@ -0,0 +1,102 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Version is a semantic version, like 1.2.3-beta.1. Columns with type
+// TypeSemver are compared as Versions.
+type Version struct {
+	Major, Minor, Patch int
+	// Prerelease is the part after "-", if any. Build metadata after "+"
+	// is ignored.
+	Prerelease string
+}
+
+// ParseVersion parses a semantic version with an optional "v" prefix.
+func ParseVersion(s string) (Version, error) {
+	invalid := fmt.Errorf("query: invalid semantic version %q", s)
+
+	v := Version{}
+	rest := strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if v.Prerelease == "" {
+			return Version{}, invalid
+		}
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return Version{}, invalid
+	}
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part[0] == '+' {
+			return Version{}, invalid
+		}
+		*numbers[i] = n
+	}
+	return v, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// compareVersions compares versions by semantic version precedence.
+func compareVersions(a, b Version) int {
+	if c := compareInts(int64(a.Major), int64(b.Major)); c != 0 {
+		return c
+	}
+	if c := compareInts(int64(a.Minor), int64(b.Minor)); c != 0 {
+		return c
+	}
+	if c := compareInts(int64(a.Patch), int64(b.Patch)); c != 0 {
+		return c
+	}
+
+	// A version without a prerelease is greater than one with it.
+	switch {
+	case a.Prerelease == b.Prerelease:
+		return 0
+	case a.Prerelease == "":
+		return 1
+	case b.Prerelease == "":
+		return -1
+	}
+
+	aIDs := strings.Split(a.Prerelease, ".")
+	bIDs := strings.Split(b.Prerelease, ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		aNum, aErr := strconv.Atoi(aIDs[i])
+		bNum, bErr := strconv.Atoi(bIDs[i])
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = compareInts(int64(aNum), int64(bNum))
+		case aErr == nil:
+			// Numeric identifiers are less than alphanumeric ones.
+			c = -1
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(aIDs[i], bIDs[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInts(int64(len(aIDs)), int64(len(bIDs)))
+}
@@ -0,0 +1,110 @@
+package query
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected Version
+	}{
+		{"1.2.3", Version{Major: 1, Minor: 2, Patch: 3}},
+		{"v0.10.0", Version{Minor: 10}},
+		{"1.0.0-rc.1+build.5", Version{Major: 1, Prerelease: "rc.1"}},
+	}
+	for _, c := range cases {
+		v, err := ParseVersion(c.s)
+		if err != nil {
+			t.Errorf("%s: %v", c.s, err)
+			continue
+		}
+		if v != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.s, c.expected, v)
+		}
+	}
+
+	for _, s := range []string{"", "1.2", "1.2.3.4", "1.x.0", "1.2.3-", "-1.2.3", "1.+2.3"} {
+		if _, err := ParseVersion(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	// In increasing order of precedence, from the semver spec.
+	versions := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.9.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := range versions {
+		for j := range versions {
+			a, _ := ParseVersion(versions[i])
+			b, _ := ParseVersion(versions[j])
+			expected := compareInts(int64(i), int64(j))
+			if c := compareVersions(a, b); c != expected {
+				t.Errorf("compare(%s, %s): expected %d, got %d", versions[i], versions[j], expected, c)
+			}
+		}
+	}
+}
+
+func TestSemverSchema(t *testing.T) {
+	table := testSliceTable{
+		{"id": 1, "version": "1.9.0"},
+		{"id": 2, "version": "1.10.0"},
+		{"id": 3, "version": "v1.2.0-beta"},
+		{"id": 4, "version": "latest"},
+	}
+	e := NewExecutor(table, WithSchema(Schema{"id": TypeInt, "version": TypeSemver}))
+
+	cases := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{`SELECT * WHERE version >= "1.2.0"`, []interface{}{1, 2}},
+		{`SELECT * WHERE version > "1.9.0"`, []interface{}{2}},
+		{`SELECT * WHERE version < "1.2.0"`, []interface{}{3}},
+		{`SELECT * WHERE version = "1.10.0+build"`, []interface{}{2}},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.query)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		res, err := e.Execute(q)
+		if err != nil {
+			t.Fatal(c.query, err)
+		}
+		ids := []interface{}{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id)
+		}
+		if len(ids) != len(c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != c.expected[i] {
+				t.Errorf("%s: expected %v, got %v", c.query, c.expected, ids)
+				break
+			}
+		}
+	}
+
+	q, err := Parse(`SELECT * WHERE version > "1.2"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Execute(q); err == nil {
+		t.Error("expected an error for an invalid version")
+	}
+}
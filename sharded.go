@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// A ShardedTable is a Table that can be read as disjoint shards, each
+// with its own cursor. The executor scans the shards concurrently for
+// queries that don't depend on row order, so rows in the result may be
+// in any order.
+type ShardedTable interface {
+	Table
+	Cursors() ([]Cursor, error)
+}
+
+// WithConcurrency sets the maximum number of shards of a ShardedTable
+// that are scanned at once. The default is runtime.GOMAXPROCS(0).
+func WithConcurrency(n int) Option {
+	return func(e *Executor) {
+		e.concurrency = n
+	}
+}
+
+// scanConcurrently is like scan for a set of cursors read by separate
+// goroutines. It stops all of them at the first error.
+func (e *Executor) scanConcurrently(ctx context.Context, cursors []Cursor, filters []Filter, match func(Row) bool) (int, error) {
+	concurrency := e.concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex // guards match and err
+		err     error
+		stopped int32
+		scanned int64
+		sem     = make(chan struct{}, concurrency)
+	)
+	stop := func() {
+		atomic.StoreInt32(&stopped, 1)
+	}
+	fail := func(cause error) {
+		mu.Lock()
+		if err == nil {
+			err = cause
+		}
+		mu.Unlock()
+		stop()
+	}
+
+	for _, cur := range cursors {
+		wg.Add(1)
+		go func(cur Cursor) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			for atomic.LoadInt32(&stopped) == 0 && cur.Next() {
+				if ctx.Err() != nil {
+					fail(ctx.Err())
+					return
+				}
+				atomic.AddInt64(&scanned, 1)
+				curRow := cur.Row()
+				ok, err := passes(filters, curRow)
+				if err != nil {
+					fail(err)
+					return
+				}
+				if !ok {
+					continue
+				}
+				mu.Lock()
+				if atomic.LoadInt32(&stopped) == 0 && !match(curRow) {
+					stop()
+				}
+				mu.Unlock()
+			}
+
+			if cur.Err() != nil {
+				fail(cur.Err())
+			}
+		}(cur)
+	}
+	wg.Wait()
+
+	return int(scanned), err
+}
@@ -0,0 +1,135 @@
+package query
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+type testShardedTable struct {
+	shards []testSliceTable
+	err    error
+}
+
+func (t testShardedTable) NewCursor() (Cursor, error) {
+	all := testSliceTable{}
+	for _, shard := range t.shards {
+		all = append(all, shard...)
+	}
+	return all.NewCursor()
+}
+
+func (t testShardedTable) Cursors() ([]Cursor, error) {
+	cursors := []Cursor{}
+	for _, shard := range t.shards {
+		cur, _ := shard.NewCursor()
+		cursors = append(cursors, cur)
+	}
+	if t.err != nil {
+		cursors = append(cursors, &errorCursor{err: t.err})
+	}
+	return cursors, nil
+}
+
+type errorCursor struct {
+	err error
+}
+
+func (c *errorCursor) Row() Row   { return nil }
+func (c *errorCursor) Next() bool { return false }
+func (c *errorCursor) Err() error { return c.err }
+
+func newTestShardedTable(shards, rowsPerShard int) testShardedTable {
+	table := testShardedTable{}
+	id := 0
+	for i := 0; i < shards; i++ {
+		shard := testSliceTable{}
+		for j := 0; j < rowsPerShard; j++ {
+			id++
+			shard = append(shard, map[string]interface{}{"id": id, "shard": i})
+		}
+		table.shards = append(table.shards, shard)
+	}
+	return table
+}
+
+func TestShardedTable(t *testing.T) {
+	table := newTestShardedTable(8, 100)
+
+	for _, concurrency := range []int{0, 1, 3} {
+		e := NewExecutor(table, WithConcurrency(concurrency))
+
+		q, err := Parse("SELECT * WHERE id > 100, id <= 300")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := e.Execute(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := []int{}
+		for _, row := range res.Rows() {
+			id, _ := row.Get("id")
+			ids = append(ids, id.(int))
+		}
+		sort.Ints(ids)
+		if len(ids) != 200 || ids[0] != 101 || ids[199] != 300 {
+			t.Errorf("concurrency %d: unexpected ids %v", concurrency, ids)
+		}
+
+		q, err = Parse("SELECT * WHERE shard != 3 LIMIT 50")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err = e.Execute(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rows := res.Rows(); len(rows) != 50 {
+			t.Errorf("concurrency %d: expected 50 rows, got %d", concurrency, len(rows))
+		}
+
+		count, err := e.Count(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 50 {
+			t.Errorf("concurrency %d: expected a count of 50, got %d", concurrency, count)
+		}
+	}
+}
+
+func TestShardedTableDistinctOn(t *testing.T) {
+	// DISTINCT ON reads the table in order rather than by shard.
+	checkIDs(t, newTestShardedTable(4, 10), "SELECT DISTINCT ON (shard) *", 1, 11, 21, 31)
+}
+
+func TestShardedTableError(t *testing.T) {
+	errShard := errors.New("shard failed")
+	table := newTestShardedTable(4, 100)
+	table.err = errShard
+
+	q, err := Parse("SELECT *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewExecutor(table).Execute(q); err != errShard {
+		t.Errorf("expected %v, got %v", errShard, err)
+	}
+	if _, err := NewExecutor(table).Count(q); err != errShard {
+		t.Errorf("expected %v, got %v", errShard, err)
+	}
+}
+
+func TestShardedTableArithmeticError(t *testing.T) {
+	// The shards are scanned concurrently, and the first error stops
+	// the scan.
+	q, err := Parse("SELECT * WHERE id = id / shard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "query: id / shard: division by zero"
+	if _, err := NewExecutor(newTestShardedTable(4, 100)).Execute(q); err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
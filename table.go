@@ -0,0 +1,54 @@
+package query
+
+import (
+	"sort"
+)
+
+// NewSliceTable returns a Table of in-memory rows. Its rows' Fields are
+// sorted by name. The maps aren't copied, so they shouldn't be modified
+// while the table is in use.
+func NewSliceTable(rows []map[string]interface{}) Table {
+	return sliceTable(rows)
+}
+
+type sliceTable []map[string]interface{}
+
+func (t sliceTable) NewCursor() (Cursor, error) {
+	return &sliceCursor{rows: t, idx: -1}, nil
+}
+
+type sliceCursor struct {
+	rows []map[string]interface{}
+	idx  int
+}
+
+func (c *sliceCursor) Next() bool {
+	if c.idx < len(c.rows) {
+		c.idx++
+	}
+	return c.idx < len(c.rows)
+}
+
+func (c *sliceCursor) Row() Row {
+	return sliceRow(c.rows[c.idx])
+}
+
+func (c *sliceCursor) Err() error {
+	return nil
+}
+
+type sliceRow map[string]interface{}
+
+func (r sliceRow) Fields() []string {
+	fields := make([]string, 0, len(r))
+	for field := range r {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func (r sliceRow) Get(field string) (interface{}, bool) {
+	v, ok := r[field]
+	return v, ok
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSliceTable(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"id": 1, "name": "a", "b": true},
+		{"id": 2},
+		{"z": nil, "id": 3, "a": 1.5, "m": "x"},
+	}
+	table := NewSliceTable(rows)
+
+	for pass := 0; pass < 2; pass++ {
+		cursor, err := table.NewCursor()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i := 0
+		for cursor.Next() {
+			row := cursor.Row()
+			expected := map[int][]string{0: {"b", "id", "name"}, 1: {"id"}, 2: {"a", "id", "m", "z"}}[i]
+			if fields := row.Fields(); !reflect.DeepEqual(fields, expected) {
+				t.Errorf("row %d: expected fields %v, got %v", i, expected, fields)
+			}
+			if id, ok := row.Get("id"); !ok || id != i+1 {
+				t.Errorf("row %d: expected id %d, got %v", i, i+1, id)
+			}
+			if v, ok := row.Get("missing"); ok {
+				t.Errorf("row %d: expected no missing field, got %v", i, v)
+			}
+			i++
+		}
+		if i != len(rows) {
+			t.Errorf("expected %d rows, got %d", len(rows), i)
+		}
+		if cursor.Next() {
+			t.Error("expected the cursor to stay done")
+		}
+		if err := cursor.Err(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if ids := executeIDs(t, table, "SELECT * WHERE id > 1"); !reflect.DeepEqual(ids, []interface{}{2, 3}) {
+		t.Errorf("expected ids 2 and 3, got %v", ids)
+	}
+}